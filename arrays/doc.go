@@ -0,0 +1,4 @@
+// Package arrays implements summation over arrays, from the SUM-ARRAY
+// procedure of CLRS Chapter 2 to compensated floating-point sums, and
+// range queries with prefix sums, Fenwick trees and segment trees.
+package arrays
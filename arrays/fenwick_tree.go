@@ -0,0 +1,73 @@
+package arrays
+
+// FenwickTree (binary indexed tree) maintains the prefix sums of an array
+// under point updates.
+//
+// Internally tree[i] (1-based) holds the sum of the lowbit(i) elements
+// ending at position i, where lowbit(i) = i & -i is the lowest set bit of
+// i. A prefix sum is assembled from O(log n) such blocks by repeatedly
+// clearing the lowest bit, and an update touches the O(log n) blocks that
+// cover a position by repeatedly adding it.
+//
+// Indices in the public API are 0-based and ranges are half-open, as with
+// slices: RangeSum(lo, hi) equals SumArray(A[lo:hi]).
+type FenwickTree[T Number] struct {
+	tree []T // 1-based; tree[0] is unused
+}
+
+// NewFenwickTree builds a Fenwick tree over a copy of the given slice.
+//
+// Each node pushes its sum to the next block that covers it, so the tree
+// is built in linear time rather than with n calls to Add.
+//
+// Time complexity: O(n)
+// Space complexity: O(n)
+func NewFenwickTree[T Number](A []T) *FenwickTree[T] {
+	tree := make([]T, len(A)+1)
+	copy(tree[1:], A)
+	for i := 1; i <= len(A); i++ {
+		if parent := i + i&-i; parent <= len(A) {
+			tree[parent] += tree[i]
+		}
+	}
+	return &FenwickTree[T]{tree: tree}
+}
+
+// Len returns the number of elements in the underlying array.
+func (f *FenwickTree[T]) Len() int {
+	return len(f.tree) - 1
+}
+
+// Add adds delta to the element at index i.
+//
+// Time complexity: O(log n)
+func (f *FenwickTree[T]) Add(i int, delta T) {
+	for i++; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+}
+
+// Set replaces the element at index i with value.
+//
+// Time complexity: O(log n)
+func (f *FenwickTree[T]) Set(i int, value T) {
+	f.Add(i, value-f.RangeSum(i, i+1))
+}
+
+// PrefixSum returns the sum of the first i elements, A[0:i].
+//
+// Time complexity: O(log n)
+func (f *FenwickTree[T]) PrefixSum(i int) T {
+	var sum T
+	for ; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of A[lo:hi].
+//
+// Time complexity: O(log n)
+func (f *FenwickTree[T]) RangeSum(lo, hi int) T {
+	return f.PrefixSum(hi) - f.PrefixSum(lo)
+}
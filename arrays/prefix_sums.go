@@ -0,0 +1,26 @@
+package arrays
+
+// PrefixSums returns the prefix sums P of the given slice, with
+// len(P) = len(A)+1 and P[i] = SumArray(A[:i]).
+//
+// After this O(n) preprocessing, the sum of any range A[lo:hi] is
+// P[hi] - P[lo] (see RangeSum), instead of an O(n) call to SumArray.
+// The array must not change afterwards; use FenwickTree or SegmentTree
+// when it does.
+//
+// Time complexity: O(n)
+// Space complexity: O(n)
+func PrefixSums[T Number](A []T) []T {
+	P := make([]T, len(A)+1)
+	for i, x := range A {
+		P[i+1] = P[i] + x
+	}
+	return P
+}
+
+// RangeSum returns the sum of A[lo:hi], given the prefix sums P of A.
+//
+// Time complexity: O(1)
+func RangeSum[T Number](P []T, lo, hi int) T {
+	return P[hi] - P[lo]
+}
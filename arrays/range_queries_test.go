@@ -0,0 +1,152 @@
+package arrays
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// randomInts returns n pseudo-random ints in [0, n), the same on every run.
+func randomInts(n int) []int {
+	rng := rand.New(rand.NewSource(1))
+	A := make([]int, n)
+	for i := range A {
+		A[i] = rng.Intn(n)
+	}
+	return A
+}
+
+// randomRange returns a random range [lo, hi) of an array of length n.
+func randomRange(rng *rand.Rand, n int) (int, int) {
+	lo := rng.Intn(n + 1)
+	hi := lo + rng.Intn(n-lo+1)
+	return lo, hi
+}
+
+func TestPrefixSums(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	A := randomInts(200)
+	P := PrefixSums(A)
+	for q := 0; q < 1000; q++ {
+		lo, hi := randomRange(rng, len(A))
+		if got, want := RangeSum(P, lo, hi), SumArray(A[lo:hi]); got != want {
+			t.Fatalf("RangeSum(%d, %d) = %d, want %d", lo, hi, got, want)
+		}
+	}
+}
+
+func TestFenwickTree(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	A := randomInts(200)
+	f := NewFenwickTree(A)
+	for q := 0; q < 5000; q++ {
+		switch i := rng.Intn(len(A)); rng.Intn(3) {
+		case 0:
+			d := rng.Intn(100) - 50
+			A[i] += d
+			f.Add(i, d)
+		case 1:
+			A[i] = rng.Intn(100)
+			f.Set(i, A[i])
+		default:
+			lo, hi := randomRange(rng, len(A))
+			if got, want := f.RangeSum(lo, hi), SumArray(A[lo:hi]); got != want {
+				t.Fatalf("RangeSum(%d, %d) = %d, want %d", lo, hi, got, want)
+			}
+		}
+	}
+}
+
+func TestSegmentTree(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	for _, n := range []int{1, 2, 7, 64, 200} {
+		A := randomInts(n)
+		s := NewSegmentTree(A)
+		for q := 0; q < 5000; q++ {
+			lo, hi := randomRange(rng, n)
+			switch rng.Intn(3) {
+			case 0:
+				d := rng.Intn(100) - 50
+				for i := lo; i < hi; i++ {
+					A[i] += d
+				}
+				s.RangeAdd(lo, hi, d)
+			case 1:
+				v := rng.Intn(100) - 50
+				for i := lo; i < hi; i++ {
+					A[i] = v
+				}
+				s.RangeAssign(lo, hi, v)
+			default:
+				if got, want := s.RangeSum(lo, hi), SumArray(A[lo:hi]); got != want {
+					t.Fatalf("n=%d: RangeSum(%d, %d) = %d, want %d", n, lo, hi, got, want)
+				}
+				if lo == hi {
+					continue
+				}
+				if got, want := s.RangeMin(lo, hi), slices.Min(A[lo:hi]); got != want {
+					t.Fatalf("n=%d: RangeMin(%d, %d) = %d, want %d", n, lo, hi, got, want)
+				}
+				if got, want := s.RangeMax(lo, hi), slices.Max(A[lo:hi]); got != want {
+					t.Fatalf("n=%d: RangeMax(%d, %d) = %d, want %d", n, lo, hi, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSegmentTreeBadRange(t *testing.T) {
+	s := NewSegmentTree([]int{3, 1, 4, 1, 5})
+	ops := []struct {
+		name string
+		call func(lo, hi int)
+	}{
+		{"RangeAdd", func(lo, hi int) { s.RangeAdd(lo, hi, 1) }},
+		{"RangeAssign", func(lo, hi int) { s.RangeAssign(lo, hi, 1) }},
+		{"RangeSum", func(lo, hi int) { s.RangeSum(lo, hi) }},
+		{"RangeMin", func(lo, hi int) { s.RangeMin(lo, hi) }},
+		{"RangeMax", func(lo, hi int) { s.RangeMax(lo, hi) }},
+	}
+	for _, op := range ops {
+		for _, r := range [][2]int{{-1, 2}, {2, 6}, {3, 2}, {5, 6}, {-2, -1}} {
+			if !panics(func() { op.call(r[0], r[1]) }) {
+				t.Errorf("%s(%d, %d) did not panic", op.name, r[0], r[1])
+			}
+		}
+	}
+
+	// An empty range is a no-op for the updates and sums to 0, but has
+	// no minimum or maximum.
+	for _, lo := range []int{0, 2, 5} {
+		s.RangeAdd(lo, lo, 7)
+		s.RangeAssign(lo, lo, 7)
+		if got := s.RangeSum(lo, lo); got != 0 {
+			t.Errorf("RangeSum(%d, %d) = %d, want 0", lo, lo, got)
+		}
+		if !panics(func() { s.RangeMin(lo, lo) }) || !panics(func() { s.RangeMax(lo, lo) }) {
+			t.Errorf("RangeMin or RangeMax of the empty range at %d did not panic", lo)
+		}
+	}
+	if got := s.RangeSum(0, 5); got != 14 {
+		t.Errorf("RangeSum(0, 5) = %d after empty updates, want 14", got)
+	}
+}
+
+// panics reports whether f panics.
+func panics(f func()) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	f()
+	return false
+}
+
+func TestSegmentTreeFloats(t *testing.T) {
+	s := NewSegmentTree([]float64{0.5, 1.5, -2, 4})
+	s.RangeAssign(1, 3, 1)
+	s.RangeAdd(0, 4, 0.25)
+	if got := s.RangeSum(0, 4); got != 7.5 {
+		t.Errorf("RangeSum = %v, want 7.5", got)
+	}
+	if got := s.RangeMin(0, 3); got != 0.75 {
+		t.Errorf("RangeMin = %v, want 0.75", got)
+	}
+}
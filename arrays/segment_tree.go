@@ -0,0 +1,203 @@
+package arrays
+
+// SegmentTree maintains an array under range updates and answers range
+// sum, minimum and maximum queries.
+//
+// Every node covers a range of the array and stores its sum, minimum and
+// maximum. Range updates use lazy propagation: a node whose range lies
+// entirely inside the update records it as a pending tag instead of
+// updating its subtree, and the tag is pushed down to the children only
+// when a later operation needs to look inside the node.
+//
+// Indices are 0-based and ranges are half-open, as with slices:
+// RangeSum(lo, hi) equals SumArray(A[lo:hi]).
+type SegmentTree[T Number] struct {
+	n     int
+	nodes []segmentNode[T] // nodes[1] is the root; children of k are 2k and 2k+1
+}
+
+// segmentNode is the aggregate of one range plus its pending updates.
+//
+// An assignment replaces any earlier pending update, and an add applied
+// on top of a pending assignment is folded into the assigned value, so a
+// node never carries both tags.
+type segmentNode[T Number] struct {
+	sum, min, max T
+
+	add      T    // pending addition for the whole range
+	assign   T    // pending assignment for the whole range...
+	assigned bool // ...valid only if assigned is true
+}
+
+// NewSegmentTree builds a segment tree over the given slice.
+//
+// Time complexity: O(n)
+// Space complexity: O(n)
+func NewSegmentTree[T Number](A []T) *SegmentTree[T] {
+	s := &SegmentTree[T]{n: len(A), nodes: make([]segmentNode[T], 4*max(len(A), 1))}
+	if len(A) > 0 {
+		s.build(A, 1, 0, len(A))
+	}
+	return s
+}
+
+// Len returns the number of elements in the underlying array.
+func (s *SegmentTree[T]) Len() int {
+	return s.n
+}
+
+// build fills node k, which covers A[l:r], and its subtree.
+func (s *SegmentTree[T]) build(A []T, k, l, r int) {
+	if r-l == 1 {
+		s.nodes[k] = segmentNode[T]{sum: A[l], min: A[l], max: A[l]}
+		return
+	}
+	m := l + (r-l)/2
+	s.build(A, 2*k, l, m)
+	s.build(A, 2*k+1, m, r)
+	s.pull(k)
+}
+
+// pull recomputes the aggregates of node k from its children.
+func (s *SegmentTree[T]) pull(k int) {
+	a, b := &s.nodes[2*k], &s.nodes[2*k+1]
+	s.nodes[k].sum = a.sum + b.sum
+	s.nodes[k].min = min(a.min, b.min)
+	s.nodes[k].max = max(a.max, b.max)
+}
+
+// applyAssign sets every element under node k, which covers width
+// elements, to v.
+func (s *SegmentTree[T]) applyAssign(k, width int, v T) {
+	x := &s.nodes[k]
+	x.sum = v * T(width)
+	x.min, x.max = v, v
+	x.assign, x.assigned = v, true
+	x.add = 0
+}
+
+// applyAdd adds d to every element under node k, which covers width
+// elements.
+func (s *SegmentTree[T]) applyAdd(k, width int, d T) {
+	x := &s.nodes[k]
+	x.sum += d * T(width)
+	x.min += d
+	x.max += d
+	if x.assigned {
+		x.assign += d
+	} else {
+		x.add += d
+	}
+}
+
+// push hands the pending tags of node k, which covers [l, r) split at m,
+// down to its children.
+func (s *SegmentTree[T]) push(k, l, m, r int) {
+	x := &s.nodes[k]
+	if x.assigned {
+		s.applyAssign(2*k, m-l, x.assign)
+		s.applyAssign(2*k+1, r-m, x.assign)
+		x.assigned = false
+	}
+	if x.add != 0 {
+		s.applyAdd(2*k, m-l, x.add)
+		s.applyAdd(2*k+1, r-m, x.add)
+		x.add = 0
+	}
+}
+
+// RangeAdd adds delta to every element of A[lo:hi]. It panics unless
+// 0 <= lo <= hi <= Len().
+//
+// Time complexity: O(log n)
+func (s *SegmentTree[T]) RangeAdd(lo, hi int, delta T) {
+	s.checkRange(lo, hi, false)
+	s.update(1, 0, s.n, lo, hi, func(k, width int) { s.applyAdd(k, width, delta) })
+}
+
+// RangeAssign sets every element of A[lo:hi] to value. It panics unless
+// 0 <= lo <= hi <= Len().
+//
+// Time complexity: O(log n)
+func (s *SegmentTree[T]) RangeAssign(lo, hi int, value T) {
+	s.checkRange(lo, hi, false)
+	s.update(1, 0, s.n, lo, hi, func(k, width int) { s.applyAssign(k, width, value) })
+}
+
+// update applies tag to every maximal node inside [lo, hi) in the subtree
+// of node k, which covers [l, r).
+func (s *SegmentTree[T]) update(k, l, r, lo, hi int, tag func(k, width int)) {
+	if hi <= l || r <= lo {
+		return
+	}
+	if lo <= l && r <= hi {
+		tag(k, r-l)
+		return
+	}
+	m := l + (r-l)/2
+	s.push(k, l, m, r)
+	s.update(2*k, l, m, lo, hi, tag)
+	s.update(2*k+1, m, r, lo, hi, tag)
+	s.pull(k)
+}
+
+// RangeSum returns the sum of A[lo:hi], 0 if the range is empty. It
+// panics unless 0 <= lo <= hi <= Len().
+//
+// Time complexity: O(log n)
+func (s *SegmentTree[T]) RangeSum(lo, hi int) T {
+	s.checkRange(lo, hi, false)
+	if lo == hi {
+		return 0
+	}
+	return s.query(1, 0, s.n, lo, hi).sum
+}
+
+// RangeMin returns the minimum of A[lo:hi]. It panics unless
+// 0 <= lo < hi <= Len().
+//
+// Time complexity: O(log n)
+func (s *SegmentTree[T]) RangeMin(lo, hi int) T {
+	s.checkRange(lo, hi, true)
+	return s.query(1, 0, s.n, lo, hi).min
+}
+
+// RangeMax returns the maximum of A[lo:hi]. It panics unless
+// 0 <= lo < hi <= Len().
+//
+// Time complexity: O(log n)
+func (s *SegmentTree[T]) RangeMax(lo, hi int) T {
+	s.checkRange(lo, hi, true)
+	return s.query(1, 0, s.n, lo, hi).max
+}
+
+// checkRange panics unless A[lo:hi] is a range of the elements, that is
+// 0 <= lo <= hi <= Len(), and, if nonEmpty, lo < hi.
+func (s *SegmentTree[T]) checkRange(lo, hi int, nonEmpty bool) {
+	if lo < 0 || hi > s.n || lo > hi {
+		panic("segment tree: range out of bounds")
+	}
+	if nonEmpty && lo == hi {
+		panic("segment tree: empty range")
+	}
+}
+
+// query combines the nodes inside [lo, hi) in the subtree of node k,
+// which covers [l, r). [lo, hi) must be non-empty and intersect [l, r).
+// Callers must only read the aggregates of the result.
+func (s *SegmentTree[T]) query(k, l, r, lo, hi int) segmentNode[T] {
+	if lo <= l && r <= hi {
+		return s.nodes[k]
+	}
+	m := l + (r-l)/2
+	s.push(k, l, m, r)
+	switch {
+	case hi <= m:
+		return s.query(2*k, l, m, lo, hi)
+	case lo >= m:
+		return s.query(2*k+1, m, r, lo, hi)
+	}
+	a := s.query(2*k, l, m, lo, hi)
+	b := s.query(2*k+1, m, r, lo, hi)
+	return segmentNode[T]{sum: a.sum + b.sum, min: min(a.min, b.min), max: max(a.max, b.max)}
+}
@@ -1,8 +1,11 @@
-package main
+package arrays
 
 // SumArray returns the sum of all elements in the given slice of integers.
 //
 // It iterates through the slice A and accumulates the total value.
+// The total silently wraps around on overflow; use Sum to detect it.
+// To answer many range sums, see PrefixSums, FenwickTree and SegmentTree.
+//
 // Time complexity: O(n)
 // Space complexity: O(1)
 func SumArray(A []int) int {
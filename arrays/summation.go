@@ -0,0 +1,181 @@
+package arrays
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Integer is the set of integer types accepted by Sum.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is the set of floating-point types accepted by the compensated
+// and pairwise summation functions.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is the set of integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+// ErrOverflow is returned (wrapped) by Sum when the total does not fit
+// in the element type.
+var ErrOverflow = errors.New("integer overflow")
+
+// Sum returns the sum of all elements in the given slice of integers.
+//
+// Unlike SumArray, which silently wraps around, Sum checks every addition
+// and returns an error wrapping ErrOverflow as soon as the running total
+// leaves the range of T.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func Sum[T Integer](A []T) (T, error) {
+	var sum T
+	for i, x := range A {
+		next := sum + x
+		// Adding a positive number must increase the total and adding
+		// a negative one must decrease it; otherwise it wrapped.
+		if (x > 0 && next < sum) || (x < 0 && next > sum) {
+			return sum, fmt.Errorf("sum overflows at index %d: %w", i, ErrOverflow)
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// KahanSum returns the sum of the given floats using Kahan's compensated
+// summation.
+//
+// A running compensation c holds the low-order bits lost by each
+// addition and feeds them back into the next one, so the error bound is
+// O(ε) independent of n, instead of O(nε) for a plain loop. It assumes
+// each element is smaller in magnitude than the running sum; see
+// NeumaierSum for inputs where that does not hold.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func KahanSum[F Float](A []F) F {
+	var sum, c F
+	for _, x := range A {
+		y := x - c
+		t := sum + y
+		c = (t - sum) - y // (t - sum) recovers the high part of y
+		sum = t
+	}
+	return sum
+}
+
+// NeumaierSum returns the sum of the given floats using Neumaier's
+// improvement of Kahan summation.
+//
+// It compensates for the lost low-order bits of whichever operand is
+// smaller, so it stays accurate when an element is larger than the
+// running sum, e.g. [1, 1e100, 1, -1e100] sums to 2 rather than 0.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func NeumaierSum[F Float](A []F) F {
+	var sum, c F
+	for _, x := range A {
+		t := sum + x
+		if abs(sum) >= abs(x) {
+			c += (sum - t) + x // low-order digits of x were lost
+		} else {
+			c += (x - t) + sum // low-order digits of sum were lost
+		}
+		sum = t
+	}
+	return sum + c
+}
+
+// pairwiseBlock is the length below which PairwiseSum adds in a loop.
+const pairwiseBlock = 128
+
+// PairwiseSum returns the sum of the given floats using pairwise
+// (cascade) summation.
+//
+// The slice is split in halves that are summed recursively and then
+// added, so every element takes part in only O(log n) roundings. Short
+// blocks are added in a plain loop to keep the recursion cheap.
+//
+// Time complexity: O(n)
+// Space complexity: O(log n) recursion stack
+func PairwiseSum[F Float](A []F) F {
+	if len(A) <= pairwiseBlock {
+		var sum F
+		for _, x := range A {
+			sum += x
+		}
+		return sum
+	}
+	middle := len(A) / 2
+	return PairwiseSum(A[:middle]) + PairwiseSum(A[middle:])
+}
+
+// ParallelSumOptions configures ParallelSum.
+type ParallelSumOptions struct {
+	// ChunkSize is the number of elements each partial sum covers.
+	// Zero or less selects 1 << 16.
+	ChunkSize int
+
+	// Workers caps the number of goroutines. Zero or less selects
+	// runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// ParallelSum returns the sum of the given floats, computed as a parallel
+// chunked reduction.
+//
+// The slice is cut into chunks of ChunkSize elements; each chunk is summed
+// with NeumaierSum by one of the workers, and the partial sums are then
+// combined with NeumaierSum in chunk order. Chunk boundaries depend only
+// on ChunkSize, never on Workers or scheduling, so the result is the same
+// bit for bit on every run and for any number of workers.
+//
+// Work: O(n)
+// Span: O(n/Workers + n/ChunkSize)
+// Space complexity: O(n/ChunkSize)
+func ParallelSum[F Float](A []F, opts ParallelSumOptions) F {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = 1 << 16
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	chunks := (len(A) + opts.ChunkSize - 1) / opts.ChunkSize
+	partials := make([]F, chunks)
+	workers := min(opts.Workers, chunks)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// Worker w sums chunks w, w+workers, w+2*workers, ...
+			for i := w; i < chunks; i += workers {
+				lo := i * opts.ChunkSize
+				hi := min(lo+opts.ChunkSize, len(A))
+				partials[i] = NeumaierSum(A[lo:hi])
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	return NeumaierSum(partials)
+}
+
+// abs returns the absolute value of x.
+func abs[F Float](x F) F {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,76 @@
+package arrays
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	if got, err := Sum([]int{1, -2, 3, 40}); got != 42 || err != nil {
+		t.Errorf("Sum = %d, %v; want 42, nil", got, err)
+	}
+	if got, err := Sum([]int8{100, 27, -127, 127}); got != 127 || err != nil {
+		t.Errorf("Sum on int8 = %d, %v; want 127, nil", got, err)
+	}
+
+	overflows := []func() error{
+		func() error { _, err := Sum([]int8{100, 28}); return err },
+		func() error { _, err := Sum([]int8{-100, -29}); return err },
+		func() error { _, err := Sum([]uint8{200, 56}); return err },
+		func() error { _, err := Sum([]int64{math.MaxInt64, 1}); return err },
+		func() error { _, err := Sum([]int{math.MinInt, -1}); return err },
+	}
+	for i, f := range overflows {
+		if err := f(); !errors.Is(err, ErrOverflow) {
+			t.Errorf("case %d: error = %v, want ErrOverflow", i, err)
+		}
+	}
+}
+
+func TestCompensatedSums(t *testing.T) {
+	// One million tenths: the plain loop drifts away from 100000.
+	tenths := make([]float64, 1_000_000)
+	for i := range tenths {
+		tenths[i] = 0.1
+	}
+	for name, sum := range map[string]func([]float64) float64{
+		"KahanSum":    KahanSum[float64],
+		"NeumaierSum": NeumaierSum[float64],
+		"PairwiseSum": PairwiseSum[float64],
+	} {
+		if got := sum(tenths); math.Abs(got-100000) > 1e-6 {
+			t.Errorf("%s(1e6 × 0.1) = %.10f, want 100000", name, got)
+		}
+	}
+
+	// Kahan loses the small terms when a term dwarfs the running sum.
+	if got := NeumaierSum([]float64{1, 1e100, 1, -1e100}); got != 2 {
+		t.Errorf("NeumaierSum = %g, want 2", got)
+	}
+}
+
+func TestParallelSumIsDeterministic(t *testing.T) {
+	rng := rand.New(rand.NewSource(8))
+	A := make([]float64, 300_001)
+	for i := range A {
+		A[i] = rng.NormFloat64() * math.Pow(10, float64(rng.Intn(20)-10))
+	}
+
+	want := ParallelSum(A, ParallelSumOptions{ChunkSize: 1000, Workers: 1})
+	for _, workers := range []int{2, 3, 8, 64} {
+		for run := 0; run < 3; run++ {
+			got := ParallelSum(A, ParallelSumOptions{ChunkSize: 1000, Workers: workers})
+			if math.Float64bits(got) != math.Float64bits(want) {
+				t.Fatalf("ParallelSum with %d workers = %v, want %v", workers, got, want)
+			}
+		}
+	}
+	if exact := NeumaierSum(A); math.Abs(want-exact) > 1e-9*math.Abs(exact) {
+		t.Errorf("ParallelSum = %v, NeumaierSum = %v", want, exact)
+	}
+	if got := ParallelSum([]float64{}, ParallelSumOptions{}); got != 0 {
+		t.Errorf("ParallelSum of empty slice = %v", got)
+	}
+}
@@ -0,0 +1,62 @@
+// Code generated by gendocbounds from the doc comments of the benchmarked packages; DO NOT EDIT.
+
+package main
+
+// docBounds holds the time bounds stated in the doc comments of the
+// benchmarked packages, keyed by "package.Function".
+var docBounds = map[string]bounds{
+	"matrix.AddMatrix":                         {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"matrix.CombineMatrix":                     {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"matrix.Multiply":                          {average: bound{exp: 3, logs: 0}, worst: bound{exp: 3, logs: 0}},
+	"matrix.MultiplyRecursive":                 {average: bound{exp: 3, logs: 0}, worst: bound{exp: 3, logs: 0}},
+	"matrix.MultiplyStrassen":                  {average: bound{exp: 2.81, logs: 0}, worst: bound{exp: 2.81, logs: 0}},
+	"matrix.SplitMatrix":                       {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"matrix.SubMatrix":                         {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"sorting.Antiqsort":                        {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.BinaryInsertionSort":              {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"sorting.BucketSort":                       {average: bound{exp: 1, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"sorting.CountingSort":                     {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.CountingSortByKey":                {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.DualPivotPartition":               {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.DualPivotQuicksort":               {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.ExternalSort":                     {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.FuzzyPartition":                   {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.FuzzySort":                        {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.FuzzySortWith":                    {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.HeapSort":                         {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.HeapSortFunc":                     {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.HoarePartition":                   {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.InsertionSort":                    {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"sorting.InsertionSortFunc":                {average: bound{exp: 2, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"sorting.Introsort":                        {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.IterativeQuicksort":               {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.KendallTauDistance":               {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.MeasurePresortedness":             {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.Median":                           {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.Merge":                            {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.MergeCountInversions":             {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.MergeFunc":                        {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.MergeSort":                        {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.MergeSortBuffered":                {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.MergeSortCountInversions":         {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.MergeSortFunc":                    {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.ParallelMergeSort":                {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.ParallelQuicksort":                {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.Partition":                        {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.Quantiles":                        {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.Quicksort":                        {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.QuicksortWith":                    {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.RadixSortInt64":                   {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.RadixSortStrings":                 {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.RadixSortUint64":                  {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.RandomizedDualPivotQuicksort":     {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.RandomizedDualPivotQuicksortWith": {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.RandomizedQuicksort":              {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.RandomizedQuicksortWith":          {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.RandomizedSelect":                 {average: bound{exp: 1, logs: 0}, worst: bound{exp: 2, logs: 0}},
+	"sorting.Select":                           {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.TailRecursiveQuicksort":           {average: bound{exp: 1, logs: 1}, worst: bound{exp: 2, logs: 0}},
+	"sorting.ThreeWayPartition":                {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+	"sorting.TimSort":                          {average: bound{exp: 1, logs: 1}, worst: bound{exp: 1, logs: 1}},
+	"sorting.TopK":                             {average: bound{exp: 1, logs: 0}, worst: bound{exp: 1, logs: 0}},
+}
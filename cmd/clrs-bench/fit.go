@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// bound is a growth rate n^exp · lg^logs n, as stated in a doc comment.
+type bound struct {
+	exp  float64
+	logs int
+}
+
+func (b bound) String() string {
+	s := "n^" + strconv.FormatFloat(b.exp, 'f', -1, 64)
+	switch b.exp {
+	case 1:
+		s = "n"
+	case 2:
+		s = "n²"
+	case 3:
+		s = "n³"
+	}
+	switch b.logs {
+	case 0:
+		return s
+	case 1:
+		return s + " lg n"
+	}
+	return fmt.Sprintf("%s lg^%d n", s, b.logs)
+}
+
+// fit is the empirical growth of one routine on one distribution.
+type fit struct {
+	Routine      string  `json:"routine"`
+	Distribution string  `json:"distribution"`
+	Bound        string  `json:"bound"`    // bound the fit is checked against
+	Expected     float64 `json:"expected"` // exponent of n in Bound
+	Exponent     float64 `json:"exponent"` // fitted exponent of n, log factors divided out
+	Flagged      bool    `json:"flagged"`
+}
+
+// fitSeries fits the growth exponent of a series of measurements.
+//
+// On random input the exponent must match the routine's average bound
+// within tolerance. On any other distribution it only must not exceed the
+// worst-case bound, since many inputs are legitimately easier (sorted
+// input for InsertionSort, for example). The log factors of the bound are
+// divided out of the times before fitting, so that n lg n is checked as an
+// exponent of 1 rather than "slightly above 1".
+//
+// It reports false if there are fewer than three measurements or r's doc
+// comment states no bounds.
+func fitSeries(r routine, d distribution, series []result, tolerance float64) (fit, bool) {
+	bounds, ok := r.bounds()
+	if len(series) < 3 || !ok {
+		return fit{}, false
+	}
+
+	b := bounds.worst
+	if d.name == "random" {
+		b = bounds.average
+	}
+
+	xs := make([]float64, len(series))
+	ys := make([]float64, len(series))
+	for i, m := range series {
+		n := float64(m.N)
+		t := max(m.Seconds, 1e-9) / math.Pow(math.Log2(n), float64(b.logs))
+		xs[i] = math.Log(n)
+		ys[i] = math.Log(t)
+	}
+	exponent := slope(xs, ys)
+
+	f := fit{
+		Routine:      r.name,
+		Distribution: d.name,
+		Bound:        b.String(),
+		Expected:     b.exp,
+		Exponent:     exponent,
+	}
+	if d.name == "random" {
+		f.Flagged = math.Abs(exponent-b.exp) > tolerance
+	} else {
+		f.Flagged = exponent > b.exp+tolerance
+	}
+	return f, true
+}
+
+// slope returns the least-squares slope of the line through (xs[i], ys[i]).
+func slope(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	var sx, sy, sxx, sxy float64
+	for i := range xs {
+		sx += xs[i]
+		sy += ys[i]
+		sxx += xs[i] * xs[i]
+		sxy += xs[i] * ys[i]
+	}
+	return (n*sxy - sx*sy) / (n*sxx - sx*sx)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// synthetic returns measurements that grow exactly like c · n^exp · lg^logs n.
+func synthetic(exp float64, logs int) []result {
+	var series []result
+	for n := 256; n <= 1<<14; n *= 2 {
+		t := 1e-9 * math.Pow(float64(n), exp) * math.Pow(math.Log2(float64(n)), float64(logs))
+		series = append(series, result{N: n, Seconds: t})
+	}
+	return series
+}
+
+func TestFitSeries(t *testing.T) {
+	random := distribution{name: "random"}
+	sorted := distribution{name: "sorted"}
+	quick := routine{name: "Quicksort", doc: "sorting.Quicksort"}
+
+	tests := []struct {
+		name    string
+		d       distribution
+		series  []result
+		want    float64
+		flagged bool
+	}{
+		{"n lg n on random input", random, synthetic(1, 1), 1, false},
+		// The lg n of the average bound is divided out: n²/lg n fits ≈1.87.
+		{"quadratic on random input", random, synthetic(2, 0), 1.87, true},
+		{"quadratic on sorted input", sorted, synthetic(2, 0), 2, false},
+		{"cubic on sorted input", sorted, synthetic(3, 0), 3, true},
+	}
+	for _, tt := range tests {
+		f, ok := fitSeries(quick, tt.d, tt.series, 0.2)
+		if !ok {
+			t.Fatalf("%s: no fit", tt.name)
+		}
+		if math.Abs(f.Exponent-tt.want) > 0.01 || f.Flagged != tt.flagged {
+			t.Errorf("%s: exponent %.3f flagged %v, want %.3f flagged %v",
+				tt.name, f.Exponent, f.Flagged, tt.want, tt.flagged)
+		}
+	}
+
+	if _, ok := fitSeries(quick, random, synthetic(1, 1)[:2], 0.2); ok {
+		t.Error("fitSeries fitted two points")
+	}
+	undocumented := routine{name: "Undocumented", doc: "sorting.Undocumented"}
+	if _, ok := fitSeries(undocumented, random, synthetic(1, 1), 0.2); ok {
+		t.Error("fitSeries fitted a routine without doc bounds")
+	}
+}
+
+func TestBoundString(t *testing.T) {
+	for b, want := range map[bound]string{
+		{exp: 1, logs: 1}: "n lg n",
+		{exp: 2}:          "n²",
+		{exp: 2.81}:       "n^2.81",
+		{exp: 1}:          "n",
+		{exp: 1, logs: 2}: "n lg^2 n",
+	} {
+		if got := b.String(); got != want {
+			t.Errorf("%#v.String() = %q, want %q", b, got, want)
+		}
+	}
+}
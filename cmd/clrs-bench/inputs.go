@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// distribution generates sort inputs of a given shape.
+type distribution struct {
+	name string
+	gen  func(n int, rng *rand.Rand) []int
+}
+
+var distributions = []distribution{
+	{"random", func(n int, rng *rand.Rand) []int {
+		return genInput(n, func(int) int { return rng.Intn(n) })
+	}},
+	{"sorted", func(n int, _ *rand.Rand) []int {
+		return genInput(n, func(i int) int { return i })
+	}},
+	{"reversed", func(n int, _ *rand.Rand) []int {
+		return genInput(n, func(i int) int { return n - i })
+	}},
+	{"all-equal", func(n int, _ *rand.Rand) []int {
+		return genInput(n, func(int) int { return 7 })
+	}},
+	{"organ-pipe", func(n int, _ *rand.Rand) []int {
+		return genInput(n, func(i int) int { return min(i, n-1-i) })
+	}},
+	{"few-unique", func(n int, rng *rand.Rand) []int {
+		return genInput(n, func(int) int { return rng.Intn(4) })
+	}},
+	{"sawtooth", func(n int, _ *rand.Rand) []int {
+		return genInput(n, func(i int) int { return i % 10 })
+	}},
+}
+
+func genInput(n int, value func(i int) int) []int {
+	A := make([]int, n)
+	for i := range A {
+		A[i] = value(i)
+	}
+	return A
+}
+
+func distributionNames() []string {
+	names := make([]string, len(distributions))
+	for i, d := range distributions {
+		names[i] = d.name
+	}
+	return names
+}
+
+// parseDistributions looks up a comma-separated list of distribution names.
+func parseDistributions(list string) ([]distribution, error) {
+	var dists []distribution
+	for _, name := range strings.Split(list, ",") {
+		found := false
+		for _, d := range distributions {
+			if d.name == strings.TrimSpace(name) {
+				dists = append(dists, d)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown distribution %q (have %s)", name, strings.Join(distributionNames(), ", "))
+		}
+	}
+	return dists, nil
+}
@@ -0,0 +1,419 @@
+// Package docbounds reads the time bounds that the doc comments of this
+// repository state, in lines such as
+//
+//	Time complexity (average): O(n log n)
+//	Time complexity (worst case): O(n²)
+//	Work: Θ(n log n)
+//
+// so that clrs-bench checks its measurements against the documentation
+// itself rather than against a copy of it.
+package docbounds
+
+import (
+	"fmt"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Bound is a growth rate n^Exp · lg^Logs n. Symbols other than n, such
+// as the key range k of counting sort, are taken to be constants.
+type Bound struct {
+	Exp  float64
+	Logs int
+}
+
+// less reports whether a grows more slowly than b.
+func (a Bound) less(b Bound) bool {
+	if a.Exp != b.Exp {
+		return a.Exp < b.Exp
+	}
+	return a.Logs < b.Logs
+}
+
+// Bounds are the time bounds stated in one doc comment.
+type Bounds struct {
+	Average Bound // expected growth on random input
+	Worst   Bound // growth that no input may exceed
+}
+
+// Dir returns the bounds stated in the doc comments of the exported
+// functions of the package in dir, keyed by "package.Function". Test
+// files are skipped.
+func Dir(dir string) (map[string]Bounds, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := make(map[string]Bounds)
+	for name, pkg := range pkgs {
+		p := doc.New(pkg, filepath.ToSlash(dir), 0)
+		funcs := p.Funcs
+		for _, t := range p.Types {
+			funcs = append(funcs, t.Funcs...)
+		}
+		for _, f := range funcs {
+			b, ok, err := Doc(f.Doc)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", name, f.Name, err)
+			}
+			if ok {
+				bounds[name+"."+f.Name] = b
+			}
+		}
+	}
+	return bounds, nil
+}
+
+// boundLine matches a line stating a time bound, with an optional label
+// such as "average" or "worst case".
+var boundLine = regexp.MustCompile(`^(?:Time complexity|Work)\s*(?:\(([^)]*)\))?\s*:\s*(.*)$`)
+
+// Doc returns the bounds stated in a doc comment, and false if it states
+// none.
+//
+// A line may state several bounds separated by commas or semicolons,
+// each qualified by "best case", "worst case", "average" or "expected"
+// either in the line's label or after the bound itself. Best cases are
+// ignored, and so are bounds on a count of operations rather than on
+// time, such as the "O(n²) moves" of "O(n log n) comparisons, O(n²)
+// moves". An unqualified bound is both the average and the worst case;
+// if the line has several, the largest one is. A comment stating only
+// one of the two uses it for both.
+func Doc(text string) (b Bounds, ok bool, err error) {
+	var average, worst []Bound
+	for _, line := range strings.Split(text, "\n") {
+		m := boundLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		label := m[1]
+		stated := m[2]
+		if i := strings.Index(stated, "//"); i >= 0 {
+			stated = stated[:i]
+		}
+
+		for _, clause := range splitClauses(stated) {
+			expr, before, after, found := cutBound(clause)
+			if !found || counted(after) {
+				continue
+			}
+			bound, err := Parse(expr)
+			if err != nil {
+				return Bounds{}, false, fmt.Errorf("%q: %w", clause, err)
+			}
+
+			switch qualifier(before+after, label) {
+			case "best":
+			case "average":
+				average = append(average, bound)
+			case "worst":
+				worst = append(worst, bound)
+			default:
+				average = append(average, bound)
+				worst = append(worst, bound)
+			}
+		}
+	}
+
+	if len(average) == 0 && len(worst) == 0 {
+		return Bounds{}, false, nil
+	}
+	if len(average) == 0 {
+		average = worst
+	}
+	if len(worst) == 0 {
+		worst = average
+	}
+	return Bounds{Average: largest(average), Worst: largest(worst)}, true, nil
+}
+
+// splitClauses splits s at the commas and semicolons outside parentheses.
+func splitClauses(s string) []string {
+	var clauses []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',', ';':
+			if depth == 0 {
+				clauses = append(clauses, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(clauses, s[start:])
+}
+
+// cutBound finds the first O(...) or Θ(...) in clause and returns what is
+// inside the parentheses, and the text of the clause before and after
+// the bound.
+func cutBound(clause string) (expr, before, after string, found bool) {
+	for _, prefix := range []string{"O(", "Θ("} {
+		i := strings.Index(clause, prefix)
+		if i < 0 {
+			continue
+		}
+		start := i + len(prefix)
+		depth := 1
+		for j := start; j < len(clause); j++ {
+			switch clause[j] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					return clause[start:j], clause[:i], clause[j+1:], true
+				}
+			}
+		}
+	}
+	return "", "", "", false
+}
+
+// operations are the words that, following a bound, make it a count of
+// operations rather than a running time.
+var operations = []string{"comparison", "move", "swap", "shift", "exchange", "write"}
+
+// counted reports whether the text after a bound starts with the name of
+// an operation, as in "O(n log n) comparisons".
+func counted(after string) bool {
+	word, _, _ := strings.Cut(strings.TrimSpace(after), " ")
+	for _, op := range operations {
+		if strings.HasPrefix(word, op) {
+			return true
+		}
+	}
+	return false
+}
+
+// qualifier returns "best", "average" or "worst" for a bound with the
+// given text around it on a line with the given label, or "" if neither
+// says which case the bound is for.
+func qualifier(rest, label string) string {
+	for _, s := range []string{rest, label} {
+		switch {
+		case strings.Contains(s, "best case"):
+			return "best"
+		case strings.Contains(s, "worst case"):
+			return "worst"
+		case strings.Contains(s, "average"), strings.Contains(s, "expected"):
+			return "average"
+		}
+	}
+	return ""
+}
+
+// largest returns the fastest-growing of bounds.
+func largest(bounds []Bound) Bound {
+	b := bounds[0]
+	for _, x := range bounds[1:] {
+		if b.less(x) {
+			b = x
+		}
+	}
+	return b
+}
+
+// Parse parses the expression inside a bound, such as "n log n", "n²",
+// "n^2.81" or "(b/r)(n + 2^r)", and returns its growth in n: the largest
+// of its terms.
+func Parse(expr string) (Bound, error) {
+	p := &exprParser{tokens: tokenize(expr)}
+	b, err := p.sum()
+	if err != nil {
+		return Bound{}, err
+	}
+	if p.pos < len(p.tokens) {
+		return Bound{}, fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	return b, nil
+}
+
+// tokenize splits expr into numbers, words, superscripts and single
+// symbols, dropping white space.
+func tokenize(expr string) []string {
+	var tokens []string
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		j := i + 1
+		switch {
+		case unicode.IsSpace(c):
+			i = j
+			continue
+		case unicode.IsDigit(c):
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+		case unicode.IsLetter(c):
+			for j < len(runes) && unicode.IsLetter(runes[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+// superscripts are the exponents written as superscript digits.
+var superscripts = map[string]float64{"²": 2, "³": 3}
+
+// exprParser is a recursive-descent parser for bound expressions.
+//
+//	sum     = product { ("+" | "-") product }
+//	product = power { ["*" | "·" | "/"] power }
+//	power   = primary [ "^" primary | superscript ]
+//	primary = "n" | number | word | "(" sum ")" | log [ "^" number | superscript ] power
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *exprParser) sum() (Bound, error) {
+	b, err := p.product()
+	if err != nil {
+		return Bound{}, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		p.next()
+		x, err := p.product()
+		if err != nil {
+			return Bound{}, err
+		}
+		if b.less(x) {
+			b = x
+		}
+	}
+	return b, nil
+}
+
+func (p *exprParser) product() (Bound, error) {
+	b, err := p.power()
+	if err != nil {
+		return Bound{}, err
+	}
+	for {
+		switch t := p.peek(); t {
+		case "", "+", "-", ")":
+			return b, nil
+		case "*", "·", "/":
+			p.next()
+			x, err := p.power()
+			if err != nil {
+				return Bound{}, err
+			}
+			if t == "/" {
+				if x != (Bound{}) {
+					return Bound{}, fmt.Errorf("division by a term in n")
+				}
+				continue
+			}
+			b = Bound{b.Exp + x.Exp, b.Logs + x.Logs}
+		default:
+			x, err := p.power()
+			if err != nil {
+				return Bound{}, err
+			}
+			b = Bound{b.Exp + x.Exp, b.Logs + x.Logs}
+		}
+	}
+}
+
+func (p *exprParser) power() (Bound, error) {
+	b, err := p.primary()
+	if err != nil {
+		return Bound{}, err
+	}
+	k, ok, err := p.exponent()
+	if err != nil || !ok {
+		return b, err
+	}
+	return Bound{b.Exp * k, int(math.Round(float64(b.Logs) * k))}, nil
+}
+
+// exponent parses an optional "^k" or superscript. A symbolic exponent
+// such as the r of 2^r is only allowed on a constant base, which it
+// leaves constant, so it is returned as 0.
+func (p *exprParser) exponent() (k float64, ok bool, err error) {
+	if k, ok := superscripts[p.peek()]; ok {
+		p.next()
+		return k, true, nil
+	}
+	if p.peek() != "^" {
+		return 0, false, nil
+	}
+	p.next()
+	t := p.next()
+	if k, err := strconv.ParseFloat(t, 64); err == nil {
+		return k, true, nil
+	}
+	if t == "" || !unicode.IsLetter([]rune(t)[0]) {
+		return 0, false, fmt.Errorf("bad exponent %q", t)
+	}
+	return 0, true, nil
+}
+
+func (p *exprParser) primary() (Bound, error) {
+	t := p.next()
+	switch {
+	case t == "n":
+		return Bound{Exp: 1}, nil
+	case t == "log" || t == "lg" || t == "ln":
+		k, ok, err := p.exponent()
+		if err != nil {
+			return Bound{}, err
+		}
+		if !ok {
+			k = 1
+		}
+		arg, err := p.power()
+		if err != nil {
+			return Bound{}, err
+		}
+		if arg == (Bound{}) {
+			return Bound{}, nil // the log of a constant
+		}
+		return Bound{Logs: int(k)}, nil
+	case t == "(":
+		b, err := p.sum()
+		if err != nil {
+			return Bound{}, err
+		}
+		if p.next() != ")" {
+			return Bound{}, fmt.Errorf("missing )")
+		}
+		return b, nil
+	case t != "" && (unicode.IsDigit([]rune(t)[0]) || unicode.IsLetter([]rune(t)[0])):
+		return Bound{}, nil // a constant
+	}
+	return Bound{}, fmt.Errorf("unexpected %q", t)
+}
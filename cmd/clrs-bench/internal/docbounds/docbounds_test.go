@@ -0,0 +1,62 @@
+package docbounds
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	for expr, want := range map[string]Bound{
+		"1":                {},
+		"n":                {Exp: 1},
+		"n log n":          {Exp: 1, Logs: 1},
+		"n lg² n":          {Exp: 1, Logs: 2},
+		"n²":               {Exp: 2},
+		"n^2.81":           {Exp: 2.81},
+		"n + k":            {Exp: 1},
+		"(b/r)(n + 2^r)":   {Exp: 1},
+		"(m/w)(n + 257^w)": {Exp: 1},
+		"log n":            {Logs: 1},
+		"n log k":          {Exp: 1},
+		"n² / 2 + n log n": {Exp: 2},
+	} {
+		got, err := Parse(expr)
+		if err != nil || got != want {
+			t.Errorf("Parse(%q) = %v, %v; want %v", expr, got, err, want)
+		}
+	}
+
+	for _, expr := range []string{"", "n / n", "(n", "n^"} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) succeeded", expr)
+		}
+	}
+}
+
+func TestDoc(t *testing.T) {
+	tests := []struct {
+		doc  string
+		want Bounds
+		ok   bool
+	}{
+		{"Sorts A.\n\nTime complexity: O(n log n)\nSpace complexity: O(n)\n",
+			Bounds{Bound{1, 1}, Bound{1, 1}}, true},
+		{"Time complexity (average): O(n log n)\nTime complexity (worst case): O(n²)\n",
+			Bounds{Bound{1, 1}, Bound{2, 0}}, true},
+		{"Time complexity: Θ(n) on average for uniform keys, Θ(n²) worst case\n",
+			Bounds{Bound{1, 0}, Bound{2, 0}}, true},
+		{"Time complexity: O(n) best case, O(n²) worst case\n",
+			Bounds{Bound{2, 0}, Bound{2, 0}}, true},
+		{"Work: Θ(n log n)\n",
+			Bounds{Bound{1, 1}, Bound{1, 1}}, true},
+		{"Time complexity: O(n²), with O(n log n) comparisons and O(n²) moves\n",
+			Bounds{Bound{2, 0}, Bound{2, 0}}, true},
+		{"Time complexity: O(n log n) comparisons, O(n²) moves\n", Bounds{}, false},
+		{"Time complexity: O(n) swaps; O(n log n) expected\n",
+			Bounds{Bound{1, 1}, Bound{1, 1}}, true},
+		{"Space complexity: O(n)\n", Bounds{}, false},
+	}
+	for _, tt := range tests {
+		got, ok, err := Doc(tt.doc)
+		if err != nil || got != tt.want || ok != tt.ok {
+			t.Errorf("Doc(%q) = %v, %v, %v; want %v, %v", tt.doc, got, ok, err, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Command gendocbounds writes the Go source of clrs-bench's docBounds
+// table: the time bounds stated in the doc comments of the packages in
+// the given directories. It is run by go generate in cmd/clrs-bench.
+//
+// Usage:
+//
+//	gendocbounds -o file dir...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/cmd/clrs-bench/internal/docbounds"
+)
+
+func main() {
+	out := flag.String("o", "doc_bounds.go", "output file")
+	flag.Parse()
+
+	src, err := generate(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gendocbounds: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0o666); err != nil {
+		fmt.Fprintf(os.Stderr, "gendocbounds: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the gofmt'd source of the docBounds table for the
+// packages in dirs.
+func generate(dirs []string) ([]byte, error) {
+	all := make(map[string]docbounds.Bounds)
+	for _, dir := range dirs {
+		bounds, err := docbounds.Dir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for name, b := range bounds {
+			all[name] = b
+		}
+	}
+
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gendocbounds from the doc comments of the benchmarked packages; DO NOT EDIT.\n\n")
+	buf.WriteString("package main\n\n")
+	buf.WriteString("// docBounds holds the time bounds stated in the doc comments of the\n")
+	buf.WriteString("// benchmarked packages, keyed by \"package.Function\".\n")
+	buf.WriteString("var docBounds = map[string]bounds{\n")
+	for _, name := range names {
+		b := all[name]
+		fmt.Fprintf(&buf, "\t%q: {average: %s, worst: %s},\n", name, literal(b.Average), literal(b.Worst))
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// literal returns b as a composite literal of clrs-bench's bound type.
+func literal(b docbounds.Bound) string {
+	return fmt.Sprintf("bound{exp: %s, logs: %d}", strconv.FormatFloat(b.Exp, 'f', -1, 64), b.Logs)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedFileUpToDate(t *testing.T) {
+	want, err := generate([]string{"../../../../sorting", "../../../../matrix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../../doc_bounds.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("doc_bounds.go is out of date with the doc comments; run go generate ./cmd/clrs-bench")
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/sorting"
+)
+
+// killerSort is a quicksort whose comparisons the killer report counts.
+type killerSort struct {
+	name string
+	sort func(A []int, cmp func(a, b int) int)
+}
+
+var killerSorts = []killerSort{
+	{"Quicksort", func(A []int, cmp func(a, b int) int) {
+		sorting.QuicksortFunc(A, 0, len(A)-1, cmp)
+	}},
+	{"RandomizedQuicksort", func(A []int, cmp func(a, b int) int) {
+		sorting.RandomizedQuicksortFunc(A, 0, len(A)-1, cmp)
+	}},
+	{"DualPivotQuicksort", func(A []int, cmp func(a, b int) int) {
+		sorting.DualPivotQuicksortFunc(A, 0, len(A)-1, cmp)
+	}},
+	{"RandomizedDualPivotQuicksort", func(A []int, cmp func(a, b int) int) {
+		sorting.RandomizedDualPivotQuicksortFunc(A, 0, len(A)-1, cmp)
+	}},
+	{"Introsort", sorting.IntrosortFunc[int]},
+}
+
+// killerInput is an input built to make quicksorts quadratic.
+type killerInput struct {
+	name string
+	gen  func(n int) []int
+}
+
+var killerInputs = []killerInput{
+	{"sorted", sorting.SortedKiller},
+	{"all-equal", sorting.AllEqualKiller},
+	{"median-of-three", sorting.MedianOfThreeKiller},
+	{"antiqsort(Quicksort)", func(n int) []int {
+		input, _ := sorting.Antiqsort(n, killerSorts[0].sort)
+		return input
+	}},
+}
+
+// adaptiveRow names the last row of the killer report: the comparisons
+// Antiqsort forces on each sort while it builds its input against that
+// very sort.
+const adaptiveRow = "antiqsort (adaptive)"
+
+// killerCounts returns the comparisons every killer sort makes on every
+// killer input of n elements, a row per input in the order of
+// killerInputs followed by the adaptive row.
+func killerCounts(n int) [][]int {
+	var rows [][]int
+	for _, in := range killerInputs {
+		input := in.gen(n)
+		row := make([]int, len(killerSorts))
+		for i, s := range killerSorts {
+			A := append([]int(nil), input...)
+			s.sort(A, func(a, b int) int {
+				row[i]++
+				return a - b
+			})
+		}
+		rows = append(rows, row)
+	}
+
+	row := make([]int, len(killerSorts))
+	for i, s := range killerSorts {
+		_, row[i] = sorting.Antiqsort(n, s.sort)
+	}
+	return append(rows, row)
+}
+
+// writeKillerReport prints a table of killerCounts(n).
+func writeKillerReport(w io.Writer, n int) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "INPUT (n = %d)\t", n)
+	for _, s := range killerSorts {
+		fmt.Fprintf(tw, "%s\t", s.name)
+	}
+	fmt.Fprintln(tw)
+
+	for r, row := range killerCounts(n) {
+		name := adaptiveRow
+		if r < len(killerInputs) {
+			name = killerInputs[r].name
+		}
+		fmt.Fprintf(tw, "%s\t", name)
+		for _, c := range row {
+			fmt.Fprintf(tw, "%d\t", c)
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+}
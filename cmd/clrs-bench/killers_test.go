@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestKillerCounts(t *testing.T) {
+	const n = 300
+	rows := killerCounts(n)
+	if len(rows) != len(killerInputs)+1 {
+		t.Fatalf("killerCounts(%d) has %d rows, want %d", n, len(rows), len(killerInputs)+1)
+	}
+	// Row 0 is sorted input and column 0 Quicksort, which then compares
+	// every pair once.
+	if got, want := rows[0][0], n*(n-1)/2; got != want {
+		t.Errorf("Quicksort made %d comparisons on sorted input, want %d", got, want)
+	}
+	for r, row := range rows {
+		for i, c := range row {
+			if c < n-1 {
+				t.Errorf("row %d: %s made %d comparisons, fewer than a sort can", r, killerSorts[i].name, c)
+			}
+		}
+	}
+}
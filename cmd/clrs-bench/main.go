@@ -0,0 +1,197 @@
+// Command clrs-bench measures the running time of the sorting and matrix
+// multiplication routines in this repository over a range of input sizes
+// and distributions, fits an empirical growth exponent to each curve, and
+// flags routines whose growth does not match the bound stated in their
+// doc comment.
+//
+// Usage:
+//
+//	clrs-bench [flags]
+//
+// The flags are:
+//
+//	-routines regexp
+//		Only run routines whose name matches (default: all).
+//	-dists list
+//		Comma-separated input distributions for the sorts (default: all).
+//	-minn n, -maxn n
+//		Smallest and largest number of elements for the sorts; sizes
+//		double in between.
+//	-minmatrix n, -maxmatrix n
+//		Smallest and largest matrix dimension (powers of two).
+//	-reps n
+//		Runs per measurement; the fastest one is kept. A run of a small
+//		input repeats the routine until it has taken minRunTime and
+//		counts the average.
+//	-format csv|json
+//		Output format of the measurements written to -o.
+//	-o file
+//		Write the measurements there instead of standard output.
+//	-tolerance t
+//		Largest accepted difference between the fitted and the
+//		expected exponent.
+//	-killers n
+//		Time nothing; instead print the comparisons the quicksorts and
+//		Introsort make on the killer inputs of package sorting with n
+//		elements, and exit.
+//
+// The fit report is printed to standard error. The exit status is 1 if
+// any routine was flagged.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+func main() {
+	var (
+		routinesFlag = flag.String("routines", "", "only run routines matching this regexp")
+		distsFlag    = flag.String("dists", strings.Join(distributionNames(), ","), "comma-separated input distributions")
+		minN         = flag.Int("minn", 1024, "smallest number of elements to sort")
+		maxN         = flag.Int("maxn", 1<<16, "largest number of elements to sort")
+		minMatrix    = flag.Int("minmatrix", 16, "smallest matrix dimension")
+		maxMatrix    = flag.Int("maxmatrix", 256, "largest matrix dimension")
+		reps         = flag.Int("reps", 3, "runs per measurement")
+		format       = flag.String("format", "csv", "output format: csv or json")
+		outFile      = flag.String("o", "", "output file (default standard output)")
+		tolerance    = flag.Float64("tolerance", 0.3, "accepted difference between fitted and expected exponent")
+		killers      = flag.Int("killers", 0, "print comparisons on the killer inputs of this size and exit")
+	)
+	flag.Parse()
+
+	if *killers > 0 {
+		writeKillerReport(os.Stdout, *killers)
+		return
+	}
+
+	filter, err := regexp.Compile(*routinesFlag)
+	if err != nil {
+		fatalf("bad -routines: %v", err)
+	}
+	dists, err := parseDistributions(*distsFlag)
+	if err != nil {
+		fatalf("bad -dists: %v", err)
+	}
+	if *format != "csv" && *format != "json" {
+		fatalf("bad -format %q: want csv or json", *format)
+	}
+	if *minN < 2 || *maxN < *minN || *minMatrix < 1 || *maxMatrix < *minMatrix || *reps < 1 {
+		fatalf("need 2 <= -minn <= -maxn, 1 <= -minmatrix <= -maxmatrix and -reps >= 1")
+	}
+
+	var out io.Writer = os.Stdout
+	if *outFile != "" {
+		f, err := os.Create(*outFile)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	cfg := config{
+		sortSizes:   [2]int{*minN, *maxN},
+		matrixSizes: [2]int{*minMatrix, *maxMatrix},
+		reps:        *reps,
+		tolerance:   *tolerance,
+	}
+	var results []result
+	var fits []fit
+	for _, r := range routines {
+		if !filter.MatchString(r.name) {
+			continue
+		}
+		for _, d := range dists {
+			if r.kind == matrixRoutine && d.name != "random" {
+				continue
+			}
+			series := measure(r, d, cfg)
+			results = append(results, series...)
+			if f, ok := fitSeries(r, d, series, cfg.tolerance); ok {
+				fits = append(fits, f)
+			}
+		}
+	}
+
+	if *format == "json" {
+		err = writeJSON(out, results, fits)
+	} else {
+		err = writeCSV(out, results)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	flagged := writeFitReport(os.Stderr, fits)
+	if flagged > 0 {
+		os.Exit(1)
+	}
+}
+
+// config holds the measurement settings shared by all routines.
+type config struct {
+	sortSizes   [2]int // smallest and largest number of elements
+	matrixSizes [2]int // smallest and largest matrix dimension
+	reps        int
+	tolerance   float64
+}
+
+// result is one measurement: the fastest of several runs of a routine on
+// one input.
+type result struct {
+	Routine      string  `json:"routine"`
+	Distribution string  `json:"distribution"`
+	N            int     `json:"n"`
+	Seconds      float64 `json:"seconds"`
+}
+
+// minRunTime is the least time one run of a measurement takes: a routine
+// that finishes sooner is repeated and its average time counted, so that
+// timer resolution and scheduling noise do not swamp small inputs.
+const minRunTime = 5 * time.Millisecond
+
+// measure runs r on inputs of distribution d for every size in the
+// configured range that is also within the routine's own limits,
+// doubling the size each time. Garbage is collected before every run, so
+// that no run pays for the allocations of the ones before it.
+func measure(r routine, d distribution, cfg config) []result {
+	sizes := cfg.sortSizes
+	if r.kind == matrixRoutine {
+		sizes = cfg.matrixSizes
+	}
+
+	var series []result
+	for n := max(sizes[0], r.minN); n <= min(sizes[1], r.maxN); n *= 2 {
+		run := r.prepare(n, d)
+		best := time.Duration(1<<63 - 1)
+		for i := 0; i < cfg.reps; i++ {
+			runtime.GC()
+			var total time.Duration
+			k := 0
+			for total < minRunTime {
+				total += run()
+				k++
+			}
+			best = min(best, total/time.Duration(k))
+		}
+		series = append(series, result{
+			Routine:      r.name,
+			Distribution: d.name,
+			N:            n,
+			Seconds:      best.Seconds(),
+		})
+	}
+	return series
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "clrs-bench: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+)
+
+// writeCSV writes one row per measurement.
+func writeCSV(w io.Writer, results []result) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"routine", "distribution", "n", "seconds"})
+	for _, r := range results {
+		cw.Write([]string{
+			r.Routine,
+			r.Distribution,
+			strconv.Itoa(r.N),
+			strconv.FormatFloat(r.Seconds, 'g', 6, 64),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSON writes the measurements and the fits as one JSON document.
+func writeJSON(w io.Writer, results []result, fits []fit) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Results []result `json:"results"`
+		Fits    []fit    `json:"fits"`
+	}{results, fits})
+}
+
+// writeFitReport prints a table of the fits and returns how many were
+// flagged.
+func writeFitReport(w io.Writer, fits []fit) int {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROUTINE\tDISTRIBUTION\tBOUND\tEXPONENT\t")
+	flagged := 0
+	for _, f := range fits {
+		mark := ""
+		if f.Flagged {
+			mark = "FLAGGED"
+			flagged++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f\t%s\n", f.Routine, f.Distribution, f.Bound, f.Exponent, mark)
+	}
+	tw.Flush()
+	return flagged
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/matrix"
+	"github.com/MohammadTaghipour/Algorithms-CLRS/sorting"
+)
+
+// routineKind says what a routine's input size n means.
+type routineKind int
+
+const (
+	sortRoutine   routineKind = iota // n elements
+	matrixRoutine                    // n×n matrices; n is a power of two
+)
+
+//go:generate go run ./internal/gendocbounds -o doc_bounds.go ../../sorting ../../matrix
+
+// routine is one algorithm under measurement.
+type routine struct {
+	name string
+	kind routineKind
+
+	// doc names the function whose doc comment states the routine's
+	// bounds, as "package.Function"; see docBounds.
+	doc string
+
+	// minN and maxN limit the sizes tried, to keep quadratic and cubic
+	// routines from running for hours and to skip sizes at which a
+	// lower-order term still outweighs the bound being checked.
+	minN, maxN int
+
+	// prepare builds an input of size n and returns a function that runs
+	// the routine once on a fresh copy of it and times only the call.
+	prepare func(n int, d distribution) func() time.Duration
+}
+
+// bounds are the time bounds stated in a doc comment.
+type bounds struct {
+	// average is the expected growth on random input; worst is the
+	// growth that no input may exceed.
+	average, worst bound
+}
+
+// bounds returns the bounds stated in r's doc comment, and false if it
+// states none.
+func (r routine) bounds() (bounds, bool) {
+	b, ok := docBounds[r.doc]
+	return b, ok
+}
+
+// routines lists every algorithm clrs-bench measures. A new sort joins it
+// here; TestRoutinesCoverSorts fails until it does or is excused there.
+var routines = []routine{
+	{"InsertionSort", sortRoutine, "sorting.InsertionSort", 0, 1 << 14, inPlace(sorting.InsertionSort[int])},
+	// BinaryInsertionSort shifts with one block copy per element, whose
+	// quadratic total only overtakes the O(n log n) comparisons from
+	// several thousand elements on.
+	{"BinaryInsertionSort", sortRoutine, "sorting.BinaryInsertionSort", 1 << 13, 1 << 16, inPlace(sorting.BinaryInsertionSort[int])},
+	{"MergeSort", sortRoutine, "sorting.MergeSort", 0, 1 << 20, inPlace(func(A []int) { sorting.MergeSort(A) })},
+	{"MergeSortBuffered", sortRoutine, "sorting.MergeSortBuffered", 0, 1 << 20, inPlace(func(A []int) {
+		sorting.MergeSortBuffered(A, nil, sorting.MergeSortOptions{Cutoff: 16})
+	})},
+	{"ParallelMergeSort", sortRoutine, "sorting.ParallelMergeSort", 0, 1 << 20, inPlace(func(A []int) {
+		sorting.ParallelMergeSort(A, sorting.ParallelMergeSortOptions{})
+	})},
+	{"TimSort", sortRoutine, "sorting.TimSort", 0, 1 << 20, inPlace(sorting.TimSort[int])},
+	{"HeapSort", sortRoutine, "sorting.HeapSort", 0, 1 << 20, inPlace(sorting.HeapSort[int])},
+	{"Quicksort", sortRoutine, "sorting.Quicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.Quicksort(A, 0, len(A)-1)
+	})},
+	{"TailRecursiveQuicksort", sortRoutine, "sorting.TailRecursiveQuicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.TailRecursiveQuicksort(A, 0, len(A)-1)
+	})},
+	{"IterativeQuicksort", sortRoutine, "sorting.IterativeQuicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.IterativeQuicksort(A, 0, len(A)-1)
+	})},
+	{"QuicksortWith/three-way", sortRoutine, "sorting.QuicksortWith", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.QuicksortWith(A, 0, len(A)-1, sorting.QuicksortOptions{Scheme: sorting.ThreeWay})
+	})},
+	{"RandomizedQuicksort", sortRoutine, "sorting.RandomizedQuicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.RandomizedQuicksort(A, 0, len(A)-1)
+	})},
+	{"RandomizedQuicksort/hoare", sortRoutine, "sorting.RandomizedQuicksortWith", 0, 1 << 20, inPlace(func(A []int) {
+		sorting.RandomizedQuicksortWith(A, 0, len(A)-1, sorting.QuicksortOptions{Scheme: sorting.Hoare})
+	})},
+	{"RandomizedQuicksort/three-way", sortRoutine, "sorting.RandomizedQuicksortWith", 0, 1 << 20, inPlace(func(A []int) {
+		sorting.RandomizedQuicksortWith(A, 0, len(A)-1, sorting.QuicksortOptions{Scheme: sorting.ThreeWay})
+	})},
+	{"DualPivotQuicksort", sortRoutine, "sorting.DualPivotQuicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.DualPivotQuicksort(A, 0, len(A)-1)
+	})},
+	{"RandomizedDualPivotQuicksort", sortRoutine, "sorting.RandomizedDualPivotQuicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.RandomizedDualPivotQuicksort(A, 0, len(A)-1)
+	})},
+	{"RandomizedDualPivotQuicksort/seeded", sortRoutine, "sorting.RandomizedDualPivotQuicksortWith", 0, 1 << 20, inPlace(func(A []int) {
+		sorting.RandomizedDualPivotQuicksortWith(A, 0, len(A)-1, sorting.QuicksortOptions{Seeded: true})
+	})},
+	{"ParallelQuicksort", sortRoutine, "sorting.ParallelQuicksort", 0, 1 << 14, inPlace(func(A []int) {
+		sorting.ParallelQuicksort(A, 0, len(A)-1, sorting.ParallelQuicksortOptions{})
+	})},
+	{"Introsort", sortRoutine, "sorting.Introsort", 0, 1 << 20, inPlace(sorting.Introsort[int])},
+	{"FuzzySort", sortRoutine, "sorting.FuzzySort", 0, 1 << 20, converted(
+		func(x int) sorting.Interval[int] { return sorting.Interval[int]{Start: x, End: x} },
+		func(A []sorting.Interval[int]) { sorting.FuzzySort(A, 0, len(A)-1) },
+	)},
+	{"FuzzySort/seeded", sortRoutine, "sorting.FuzzySortWith", 0, 1 << 20, converted(
+		func(x int) sorting.Interval[int] { return sorting.Interval[int]{Start: x, End: x} },
+		func(A []sorting.Interval[int]) {
+			sorting.FuzzySortWith(A, 0, len(A)-1, sorting.QuicksortOptions{Seeded: true})
+		},
+	)},
+	{"CountingSort", sortRoutine, "sorting.CountingSort", 0, 1 << 20, inPlace(func(A []int) { sorting.CountingSort(A) })},
+	{"BucketSort", sortRoutine, "sorting.BucketSort", 0, 1 << 14, func(n int, d distribution) func() time.Duration {
+		// Every distribution draws its keys from [0, n], which this maps
+		// onto [0, 1) in order. Few distinct keys share a bucket, whose
+		// insertion sort is then quadratic; hence the InsertionSort limit.
+		return converted(
+			func(x int) float64 { return float64(x) / float64(n+1) },
+			func(A []float64) { sorting.BucketSort(A) },
+		)(n, d)
+	}},
+	{"RadixSortUint64", sortRoutine, "sorting.RadixSortUint64", 0, 1 << 20, converted(
+		func(x int) uint64 { return uint64(x) },
+		func(A []uint64) { sorting.RadixSortUint64(A, sorting.RadixSortOptions{}) },
+	)},
+	{"RadixSortInt64", sortRoutine, "sorting.RadixSortInt64", 0, 1 << 20, converted(
+		func(x int) int64 { return int64(x) },
+		func(A []int64) { sorting.RadixSortInt64(A, sorting.RadixSortOptions{}) },
+	)},
+	{"RadixSortStrings", sortRoutine, "sorting.RadixSortStrings", 0, 1 << 20, converted(
+		// A fixed width keeps the string length m, and so the number of
+		// passes, independent of n.
+		func(x int) string { return fmt.Sprintf("%08d", x) },
+		func(A []string) { sorting.RadixSortStrings(A, sorting.RadixSortOptions{}) },
+	)},
+
+	{"Multiply", matrixRoutine, "matrix.Multiply", 16, 256, multiply(func(A, B [][]int) {
+		matrix.Multiply(A, B, matrix.MakeMatrix(len(A)), len(A))
+	})},
+	{"MultiplyRecursive", matrixRoutine, "matrix.MultiplyRecursive", 16, 128, multiply(func(A, B [][]int) {
+		matrix.MultiplyRecursive(A, B)
+	})},
+	// Below 32 Strassen's eighteen Θ(n²) additions and their allocations
+	// outweigh the seven recursive products that give it its bound.
+	{"MultiplyStrassen", matrixRoutine, "matrix.MultiplyStrassen", 32, 256, multiply(func(A, B [][]int) {
+		matrix.MultiplyStrassen(A, B)
+	})},
+}
+
+// inPlace adapts a sort of []int to routine.prepare.
+func inPlace(sort func(A []int)) func(n int, d distribution) func() time.Duration {
+	return func(n int, d distribution) func() time.Duration {
+		input := d.gen(n, rand.New(rand.NewSource(int64(n))))
+		A := make([]int, n)
+		return func() time.Duration {
+			copy(A, input)
+			start := time.Now()
+			sort(A)
+			return time.Since(start)
+		}
+	}
+}
+
+// converted adapts a sort of []T to routine.prepare. The generated ints
+// are converted to T by conv before timing starts.
+func converted[T any](conv func(x int) T, sort func(A []T)) func(n int, d distribution) func() time.Duration {
+	return func(n int, d distribution) func() time.Duration {
+		input := make([]T, n)
+		for i, x := range d.gen(n, rand.New(rand.NewSource(int64(n)))) {
+			input[i] = conv(x)
+		}
+		A := make([]T, n)
+		return func() time.Duration {
+			copy(A, input)
+			start := time.Now()
+			sort(A)
+			return time.Since(start)
+		}
+	}
+}
+
+// multiply adapts a multiplication of n×n matrices to routine.prepare.
+// The distribution is ignored; entries are random.
+func multiply(mul func(A, B [][]int)) func(n int, d distribution) func() time.Duration {
+	return func(n int, _ distribution) func() time.Duration {
+		rng := rand.New(rand.NewSource(int64(n)))
+		A, B := matrix.MakeMatrix(n), matrix.MakeMatrix(n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				A[i][j] = rng.Intn(100)
+				B[i][j] = rng.Intn(100)
+			}
+		}
+		return func() time.Duration {
+			start := time.Now()
+			mul(A, B)
+			return time.Since(start)
+		}
+	}
+}
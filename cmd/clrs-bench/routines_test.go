@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/cmd/clrs-bench/internal/docbounds"
+)
+
+// notBenchmarked lists the sorting functions that state a bound but have
+// no routine, and why.
+var notBenchmarked = map[string]string{
+	"sorting.Antiqsort":                "builds an adversarial input; it does not sort",
+	"sorting.ExternalSort":             "sorts files, so its time is dominated by I/O",
+	"sorting.CountingSortByKey":        "is measured through CountingSort, which calls it",
+	"sorting.MergeSortCountInversions": "is MergeSort with a counter added to each merge",
+	"sorting.MeasurePresortedness":     "is not a sort; it runs MergeSortCountInversions",
+}
+
+func TestRoutineBounds(t *testing.T) {
+	for _, r := range routines {
+		if _, ok := r.bounds(); !ok {
+			t.Errorf("%s: the doc comment of %s states no time bound", r.name, r.doc)
+		}
+	}
+}
+
+func TestRoutinesCoverSorts(t *testing.T) {
+	measured := make(map[string]bool)
+	for _, r := range routines {
+		measured[r.doc] = true
+	}
+
+	bounds, err := docbounds.Dir("../../sorting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name := range bounds {
+		fn := strings.TrimPrefix(name, "sorting.")
+		if !strings.Contains(strings.ToLower(fn), "sort") || variant(fn) {
+			continue
+		}
+		if !measured[name] && notBenchmarked[name] == "" {
+			t.Errorf("%s has no routine; add one to routines or a reason to notBenchmarked", name)
+		}
+		if measured[name] && notBenchmarked[name] != "" {
+			t.Errorf("%s has a routine but is listed in notBenchmarked", name)
+		}
+	}
+}
+
+// variant reports whether name is a comparator, instrumented or traced
+// variant of a sort, which runs the same code as the sort itself.
+func variant(name string) bool {
+	for _, suffix := range []string{"Func", "WithStats", "Traced"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
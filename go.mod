@@ -0,0 +1,3 @@
+module github.com/MohammadTaghipour/Algorithms-CLRS
+
+go 1.22
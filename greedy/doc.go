@@ -0,0 +1,4 @@
+// Package greedy implements the greedy algorithms of CLRS Chapter 15:
+// activity selection, the fractional knapsack, Huffman codes and
+// offline caching.
+package greedy
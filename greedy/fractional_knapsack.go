@@ -1,4 +1,4 @@
-package main
+package greedy
 
 // FractionalKnapsack computes the maximum total value that fits in a knapsack of capacity W.
 //
@@ -0,0 +1,58 @@
+package greedy
+
+import "github.com/MohammadTaghipour/Algorithms-CLRS/heap"
+
+// Huffman constructs a Huffman tree from a set of characters and
+// their frequencies using a greedy algorithm.
+//
+// The algorithm repeatedly combines the two nodes with the smallest
+// frequencies into a new internal node whose frequency is the sum
+// of the two. This process continues until only one node remains,
+// which becomes the root of the Huffman tree.
+//
+// Time complexity: O(n log n)
+//   - Building the initial min-heap takes O(n)
+//   - Each of the n−1 iterations performs two Extract-Min and one Insert,
+//     each costing O(log n)
+//
+// Space complexity: O(n)
+//   - n nodes are stored in the heap and the resulting tree
+func Huffman(C map[rune]int) *HuffmanNode {
+	var nodes []*HuffmanNode
+	for ch, freq := range C {
+		nodes = append(nodes, &HuffmanNode{
+			Char: ch,
+			Freq: freq,
+		})
+	}
+
+	// Q is a min-priority queue keyed on Freq
+	Q := heap.FromSlice(nodes, func(a, b *HuffmanNode) bool {
+		return a.Freq < b.Freq
+	})
+	n := len(nodes)
+
+	for i := 0; i < n-1; i++ {
+		x, _ := Q.Pop()
+		y, _ := Q.Pop()
+
+		z := &HuffmanNode{
+			Freq:  x.Freq + y.Freq,
+			Left:  x,
+			Right: y,
+		}
+		Q.Push(z)
+	}
+	root, _ := Q.Pop()
+	return root
+}
+
+// HuffmanNode is a node of a Huffman tree. Leaves carry a character;
+// the codeword of a character is the path from the root to its leaf,
+// 0 for every Left edge and 1 for every Right edge.
+type HuffmanNode struct {
+	Char  rune         // the character, meaningful only at a leaf
+	Freq  int          // the total frequency of the subtree's characters
+	Left  *HuffmanNode // nil at a leaf
+	Right *HuffmanNode // nil at a leaf
+}
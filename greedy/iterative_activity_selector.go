@@ -1,4 +1,4 @@
-package main
+package greedy
 
 // IterativeActivitySelector selects the maximum set of mutually compatible activities
 // from a given list of activities with start times s and finish times f using an iterative greedy approach.
@@ -23,6 +23,9 @@ package main
 //            - Update k to m
 //   3. Return the selected set A.
 //
+// SelectActivities does the same for activities given as sorting.Interval
+// values in any order.
+//
 // Time complexity: O(n) — each activity is considered exactly once
 // Space complexity: O(n) — for storing the selected activity indices
 func IterativeActivitySelector(s, f []int, n int) []int {
@@ -1,4 +1,4 @@
-package main
+package greedy
 
 // OfflineCaching computes the number of cache hits for a given sequence of requests
 // using the offline (furthest-in-future) caching strategy.
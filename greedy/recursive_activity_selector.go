@@ -1,4 +1,4 @@
-package main
+package greedy
 
 // RecursiveActivitySelector selects the maximum set of mutually compatible activities
 // from a given list of activities with start times s and finish times f.
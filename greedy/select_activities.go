@@ -0,0 +1,40 @@
+package greedy
+
+import (
+	"cmp"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/sorting"
+)
+
+// SelectActivities selects a maximum set of mutually compatible activities,
+// where activity i occupies the half-open interval
+// [activities[i].Start, activities[i].End).
+//
+// Unlike IterativeActivitySelector, it takes the activities in any order:
+// their indices are first sorted by finish time with sorting.MergeSortFunc,
+// and then the activities are chosen greedily as in GREEDY-ACTIVITY-SELECTOR.
+// Activities that finish at the same time are considered in input order.
+//
+// Returns:
+//
+//	[]int : indices into activities of the selected activities, in order of finish time
+//
+// Time complexity: O(n log n) — dominated by the sort
+// Space complexity: O(n)
+func SelectActivities[T cmp.Ordered](activities []sorting.Interval[T]) []int {
+	order := make([]int, len(activities))
+	for i := range order {
+		order[i] = i
+	}
+	order = sorting.MergeSortFunc(order, func(i, j int) int {
+		return cmp.Compare(activities[i].End, activities[j].End)
+	})
+
+	A := []int{}
+	for _, m := range order {
+		if len(A) == 0 || activities[m].Start >= activities[A[len(A)-1]].End {
+			A = append(A, m)
+		}
+	}
+	return A
+}
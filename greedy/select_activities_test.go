@@ -0,0 +1,33 @@
+package greedy
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/sorting"
+)
+
+func TestSelectActivities(t *testing.T) {
+	// The activities of CLRS Figure 15.1, shuffled.
+	s := []int{0, 1, 3, 0, 5, 3, 5, 6, 7, 8, 2, 12}
+	f := []int{0, 4, 5, 6, 7, 9, 9, 10, 11, 12, 14, 16}
+	perm := []int{7, 2, 11, 4, 1, 9, 3, 10, 6, 8, 5}
+
+	activities := make([]sorting.Interval[int], len(perm))
+	for i, k := range perm {
+		activities[i] = sorting.Interval[int]{Start: s[k], End: f[k]}
+	}
+
+	var got []int
+	for _, i := range SelectActivities(activities) {
+		got = append(got, perm[i])
+	}
+	want := IterativeActivitySelector(s, f, 11)
+	if !slices.Equal(got, want) {
+		t.Errorf("SelectActivities chose activities %v, want %v", got, want)
+	}
+
+	if got := SelectActivities([]sorting.Interval[int]{}); len(got) != 0 {
+		t.Errorf("SelectActivities of no activities = %v, want none", got)
+	}
+}
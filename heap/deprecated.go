@@ -0,0 +1,44 @@
+package heap
+
+// IntHeap is the heap of ints that this package provided before Heap
+// became generic.
+//
+// Deprecated: Use Heap[int]. Heap itself is now generic, so code that
+// names the type as plain Heap no longer compiles and has to be changed
+// to IntHeap or Heap[int]; this is a breaking change.
+type IntHeap = Heap[int]
+
+// NewHeap builds a heap from the given slice in place, ordered by
+// betterThan: the root is an element that no other element is betterThan.
+//
+// Deprecated: Use FromSlice.
+func NewHeap[T any](arr []T, betterThan func(a, b T) bool) *Heap[T] {
+	return FromSlice(arr, betterThan)
+}
+
+// ExtractRoot removes and returns the root element of the heap.
+//
+// Deprecated: Use Pop.
+func (h *Heap[T]) ExtractRoot() (T, error) {
+	return h.Pop()
+}
+
+// Insert adds a new key into the heap.
+//
+// Deprecated: Use Push.
+func (h *Heap[T]) Insert(key T) {
+	h.Push(key)
+}
+
+// UpdateKey updates the value of the key at index i and restores the
+// heap property. It returns ErrIndexOutOfRange, leaving the heap
+// unchanged, if i is not an index of the heap.
+//
+// Deprecated: Change the element and call Fix.
+func (h *Heap[T]) UpdateKey(i int, newKey T) error {
+	if i < 0 || i >= h.Len() {
+		return ErrIndexOutOfRange
+	}
+	h.data[i] = newKey
+	return h.Fix(i)
+}
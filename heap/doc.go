@@ -0,0 +1,6 @@
+// Package heap implements the binary heap and priority queue operations
+// of CLRS Chapter 6 as a generic Heap[T] ordered by a less function.
+//
+// HeapSort in package sorting, Huffman in package greedy and the k-way
+// merge of the external sort are all built on it.
+package heap
@@ -0,0 +1,294 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+)
+
+// ErrUnderflow is returned by Peek and Pop on an empty heap.
+var ErrUnderflow = errors.New("heap underflow error")
+
+// ErrIndexOutOfRange is returned by Fix and Remove for an index that is
+// not in the heap.
+var ErrIndexOutOfRange = errors.New("heap index out of range")
+
+// Heap represents a binary heap of elements of type T, ordered by a less
+// function. The root is an element that no other element is less than,
+// so the heap is a min-heap for less(a, b) = a < b and a max-heap for
+// less(a, b) = a > b.
+//
+// Fields:
+//   - data: the underlying slice storing the heap elements, data[0] being
+//     the root
+//   - less: a comparison function that defines the heap property: no
+//     element is less than its parent
+//   - obs: told about every step of the heap's work, if not nil
+type Heap[T any] struct {
+	data []T
+	less func(a, b T) bool
+	obs  Observer
+}
+
+// Observer is told about the steps a Heap takes, so that instrumented
+// and traced algorithms built on a Heap, such as sorting.HeapSortTraced,
+// can follow its work without a copy of its code. Indices are into the
+// heap's storage.
+type Observer interface {
+	// Heapify is called when heapify starts at node i of a heap of n
+	// elements.
+	Heapify(i, n int)
+
+	// Compare is called just before the elements at i and j are
+	// compared with less.
+	Compare(i, j int)
+
+	// Swap is called just after the elements at i and j are exchanged.
+	Swap(i, j int)
+}
+
+// New returns an empty heap ordered by less.
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// NewMin returns an empty min-heap, whose root is its smallest element.
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return New(cmp.Less[T])
+}
+
+// NewMax returns an empty max-heap, whose root is its largest element.
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// FromSlice builds a heap ordered by less from the elements of A, using A
+// itself as the heap's storage (BUILD-HEAP in CLRS).
+//
+// It calls heapify in a bottom-up manner, from the last internal node to
+// the root, to establish the heap property.
+//
+// Pop and Remove move the element they remove to just past the end of
+// the heap, so popping every element of a heap built on A leaves A
+// holding them in the reverse of the order they were popped in. This is
+// how HeapSort sorts in place. Push may append past the end of A.
+//
+// Time complexity: O(n)
+func FromSlice[T any](A []T, less func(a, b T) bool) *Heap[T] {
+	return FromSliceObserved(A, less, nil)
+}
+
+// FromSliceObserved is FromSlice for a heap that reports every step it
+// takes, from the building of the heap on, to obs.
+//
+// Time complexity: O(n)
+func FromSliceObserved[T any](A []T, less func(a, b T) bool, obs Observer) *Heap[T] {
+	h := &Heap[T]{data: A, less: less, obs: obs}
+	for i := len(A)/2 - 1; i >= 0; i-- {
+		h.heapify(i)
+	}
+	return h
+}
+
+// BuildMinHeap builds a min-heap from the elements of A in place, as by
+// FromSlice.
+//
+// Time complexity: O(n)
+func BuildMinHeap[T cmp.Ordered](A []T) *Heap[T] {
+	return FromSlice(A, cmp.Less[T])
+}
+
+// BuildMaxHeap builds a max-heap from the elements of A in place, as by
+// FromSlice.
+//
+// Time complexity: O(n)
+func BuildMaxHeap[T cmp.Ordered](A []T) *Heap[T] {
+	return FromSlice(A, func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// parent returns the index of the parent of the node at index i.
+//
+// For a 0-based array, parent(i) = (i-1)/2
+// in CLRS parent(i) = floor(i/2)
+func parent(i int) int {
+	return (i - 1) / 2
+}
+
+// left returns the index of the left child of the node at index i.
+//
+// For a 0-based array, left(i) = 2*i + 1
+// in CLRS left(i) = 2*i
+func left(i int) int {
+	return 2*i + 1
+}
+
+// right returns the index of the right child of the node at index i.
+//
+// For a 0-based array, right(i) = 2*i + 2
+// in CLRS right(i) = 2*i + 1
+func right(i int) int {
+	return 2*i + 2
+}
+
+// heapify restores the heap property for the subtree rooted at index i.
+//
+// It assumes that the binary trees rooted at left(i) and right(i)
+// already satisfy the heap property. If the element at i violates
+// the heap property, it "floats down" to the correct position by
+// repeatedly swapping with the better child.
+//
+// Time complexity: O(log n) where n is the size of the heap
+func (h *Heap[T]) heapify(i int) {
+	n := len(h.data)
+	if h.obs != nil {
+		h.obs.Heapify(i, n)
+	}
+	for {
+		l := left(i)
+		r := right(i)
+
+		best := i
+		if l < n && h.lessAt(l, best) {
+			best = l
+		}
+		if r < n && h.lessAt(r, best) {
+			best = r
+		}
+
+		if best == i {
+			return
+		}
+		h.swap(i, best)
+		i = best
+	}
+}
+
+// bubbleUp moves the element at index i toward the root until it is not
+// less than its parent, and reports whether it moved.
+//
+// Time complexity: O(log n)
+func (h *Heap[T]) bubbleUp(i int) bool {
+	start := i
+	for i > 0 && h.lessAt(i, parent(i)) {
+		h.swap(i, parent(i))
+		i = parent(i)
+	}
+	return i != start
+}
+
+// lessAt reports whether the element at i is less than the one at j.
+func (h *Heap[T]) lessAt(i, j int) bool {
+	if h.obs != nil {
+		h.obs.Compare(i, j)
+	}
+	return h.less(h.data[i], h.data[j])
+}
+
+// swap exchanges the elements at i and j.
+func (h *Heap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	if h.obs != nil {
+		h.obs.Swap(i, j)
+	}
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.data)
+}
+
+// Push adds x to the heap (MAX-HEAP-INSERT or MIN-HEAP-INSERT in CLRS).
+//
+// The element is first appended at the end of the array to maintain
+// the complete binary tree structure, then bubbled up to restore the
+// heap property.
+//
+// Time complexity: O(log n)
+// Space complexity: O(1) amortized
+func (h *Heap[T]) Push(x T) {
+	h.data = append(h.data, x)
+	h.bubbleUp(len(h.data) - 1)
+}
+
+// Pop removes and returns the root of the heap.
+//
+// In a max-heap, this corresponds to EXTRACT-MAX.
+// In a min-heap, this corresponds to EXTRACT-MIN.
+//
+// Algorithm (CLRS-style):
+// 1. Swap the root with the last element of the heap.
+// 2. Decrease the heap size, leaving the old root just past its end.
+// 3. Call heapify(0) to restore the heap property.
+//
+// Time complexity: O(log n)
+// Space complexity: O(1)
+func (h *Heap[T]) Pop() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrUnderflow
+	}
+	return h.remove(0), nil
+}
+
+// Peek returns the root element of the heap without removing it.
+//
+// For a max-heap, this returns the maximum element.
+// For a min-heap, this returns the minimum element.
+//
+// Time complexity: O(1)
+// Space complexity: O(1)
+func (h *Heap[T]) Peek() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrUnderflow
+	}
+	return h.data[0], nil
+}
+
+// Fix restores the heap property after the element at index i has
+// changed, either through a pointer or through the slice given to
+// FromSlice.
+//
+// If the element is now less than its parent, it is bubbled up toward
+// the root; otherwise it may be greater than its children, and heapify
+// moves it down. Changing the element and calling Fix corresponds to
+// INCREASE-KEY and DECREASE-KEY in CLRS.
+//
+// Time complexity: O(log n)
+// Space complexity: O(1)
+func (h *Heap[T]) Fix(i int) error {
+	if i < 0 || i >= len(h.data) {
+		return ErrIndexOutOfRange
+	}
+	if !h.bubbleUp(i) {
+		h.heapify(i)
+	}
+	return nil
+}
+
+// Remove removes and returns the element at index i of the heap.
+//
+// The last element of the heap takes its place and is moved up or down
+// as by Fix.
+//
+// Time complexity: O(log n)
+// Space complexity: O(1)
+func (h *Heap[T]) Remove(i int) (T, error) {
+	if i < 0 || i >= len(h.data) {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	return h.remove(i), nil
+}
+
+// remove swaps the element at index i with the last one, shrinks the
+// heap by one and restores the heap property at i.
+func (h *Heap[T]) remove(i int) T {
+	n := len(h.data) - 1
+	h.swap(i, n)
+	x := h.data[n]
+	h.data = h.data[:n]
+	if i < n {
+		h.Fix(i)
+	}
+	return x
+}
@@ -0,0 +1,189 @@
+package heap
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// drain pops every element of h in order.
+func drain[T any](t *testing.T, h *Heap[T]) []T {
+	t.Helper()
+	var out []T
+	for h.Len() > 0 {
+		x, err := h.Pop()
+		if err != nil {
+			t.Fatalf("Pop: %v", err)
+		}
+		out = append(out, x)
+	}
+	return out
+}
+
+func TestHeapOrder(t *testing.T) {
+	in := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}
+
+	want := slices.Clone(in)
+	slices.Sort(want)
+	if got := drain(t, BuildMinHeap(slices.Clone(in))); !slices.Equal(got, want) {
+		t.Errorf("min-heap order = %v, want %v", got, want)
+	}
+
+	minHeap := NewMin[int]()
+	for _, x := range in {
+		minHeap.Push(x)
+	}
+	if got := drain(t, minHeap); !slices.Equal(got, want) {
+		t.Errorf("NewMin order = %v, want %v", got, want)
+	}
+
+	slices.Reverse(want)
+	if got := drain(t, BuildMaxHeap(slices.Clone(in))); !slices.Equal(got, want) {
+		t.Errorf("max-heap order = %v, want %v", got, want)
+	}
+
+	maxHeap := NewMax[int]()
+	for _, x := range in {
+		maxHeap.Push(x)
+	}
+	if got := drain(t, maxHeap); !slices.Equal(got, want) {
+		t.Errorf("NewMax order = %v, want %v", got, want)
+	}
+}
+
+func TestHeapPushAfterPop(t *testing.T) {
+	h := BuildMinHeap([]int{5, 7, 9})
+	if x, _ := h.Pop(); x != 5 {
+		t.Fatalf("Pop = %d, want 5", x)
+	}
+	h.Push(1)
+	h.Push(8)
+	if x, _ := h.Peek(); x != 1 {
+		t.Errorf("Peek = %d, want 1", x)
+	}
+	if got, want := drain(t, h), []int{1, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+}
+
+func TestHeapUnderflow(t *testing.T) {
+	h := NewMax[int]()
+	if _, err := h.Peek(); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("Peek on empty heap: err = %v, want ErrUnderflow", err)
+	}
+	if _, err := h.Pop(); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("Pop on empty heap: err = %v, want ErrUnderflow", err)
+	}
+	if err := h.Fix(0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Fix on empty heap: err = %v, want ErrIndexOutOfRange", err)
+	}
+	if _, err := h.Remove(0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Remove on empty heap: err = %v, want ErrIndexOutOfRange", err)
+	}
+}
+
+func TestHeapFix(t *testing.T) {
+	A := []int{4, 8, 2, 6, 7, 1}
+	h := FromSlice(A, func(a, b int) bool { return a > b })
+
+	// Raise a leaf above the root, then sink the new root below everything.
+	A[len(A)-1] = 10
+	if err := h.Fix(len(A) - 1); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if x, _ := h.Peek(); x != 10 {
+		t.Errorf("Peek after raising a key = %d, want 10", x)
+	}
+	A[0] = 0
+	if err := h.Fix(0); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if got, want := drain(t, h), []int{8, 7, 6, 4, 2, 0}; !slices.Equal(got, want) {
+		t.Errorf("order after Fix = %v, want %v", got, want)
+	}
+}
+
+func TestHeapRemove(t *testing.T) {
+	in := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}
+	for i := range in {
+		h := BuildMinHeap(slices.Clone(in))
+		x, err := h.Remove(i)
+		if err != nil {
+			t.Fatalf("Remove(%d): %v", i, err)
+		}
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		j := slices.Index(want, x)
+		want = slices.Delete(want, j, j+1)
+		if got := drain(t, h); !slices.Equal(got, want) {
+			t.Errorf("Remove(%d) = %d, then order = %v, want %v", i, x, got, want)
+		}
+	}
+}
+
+func TestFromSliceSortsInPlace(t *testing.T) {
+	A := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}
+	want := slices.Clone(A)
+	slices.Sort(want)
+
+	// Popping a max-heap leaves A in ascending order.
+	drain(t, BuildMaxHeap(A))
+	if !slices.Equal(A, want) {
+		t.Errorf("A after popping its max-heap = %v, want %v", A, want)
+	}
+}
+
+// countingObserver counts the steps reported to it.
+type countingObserver struct {
+	heapifies, compares, swaps int
+}
+
+func (o *countingObserver) Heapify(i, n int) { o.heapifies++ }
+func (o *countingObserver) Compare(i, j int) { o.compares++ }
+func (o *countingObserver) Swap(i, j int)    { o.swaps++ }
+
+func TestFromSliceObserved(t *testing.T) {
+	A := []int{1, 2, 3, 4, 5, 6, 7}
+	compares := 0
+	var obs countingObserver
+	h := FromSliceObserved(A, func(a, b int) bool {
+		compares++
+		return a > b
+	}, &obs)
+
+	// Building the heap heapifies the 3 internal nodes; on ascending
+	// input every one of them sinks to the bottom.
+	if obs.heapifies != 3 || obs.swaps != 4 {
+		t.Errorf("building reported %+v, want 3 heapifies and 4 swaps", obs)
+	}
+
+	drain(t, h)
+	if obs.compares != compares {
+		t.Errorf("observer saw %d comparisons, less was called %d times", obs.compares, compares)
+	}
+}
+
+func TestDeprecatedWrappers(t *testing.T) {
+	var h *IntHeap = NewHeap([]int{3, 1, 4, 1, 5}, func(a, b int) bool { return a > b })
+	h.Insert(9)
+	if err := h.UpdateKey(0, 0); err != nil { // the root, 9, becomes the smallest key
+		t.Fatalf("UpdateKey(0, 0): %v", err)
+	}
+	for _, i := range []int{-1, h.Len()} {
+		if err := h.UpdateKey(i, 7); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("UpdateKey(%d, 7): err = %v, want ErrIndexOutOfRange", i, err)
+		}
+	}
+	if root, err := h.ExtractRoot(); err != nil || root != 5 {
+		t.Errorf("ExtractRoot() = %d, %v, want 5", root, err)
+	}
+	if got, want := drain(t, h), []int{4, 3, 1, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("remaining order = %v, want %v", got, want)
+	}
+
+	words := NewHeap([]string{"b", "c", "a"}, func(a, b string) bool { return a < b })
+	if root, err := words.ExtractRoot(); err != nil || root != "a" {
+		t.Errorf("ExtractRoot() = %q, %v, want \"a\"", root, err)
+	}
+}
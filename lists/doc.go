@@ -0,0 +1,3 @@
+// Package lists implements the elementary data structures of CLRS
+// Chapter 10: stacks, queues and doubly linked lists.
+package lists
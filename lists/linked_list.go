@@ -1,4 +1,4 @@
-package main
+package lists
 
 // Node represents a single element in a doubly linked list.
 // It holds a value of type T and pointers to the next and previous nodes.
@@ -37,7 +37,8 @@ func (l *LinkedList[T]) FindFirst(value T) (*Node[T], bool) {
 }
 
 // InsertFirst inserts a new value at the beginning of the list.
-// The new node becomes the head of the list.
+// The new node becomes the head of the list, and also its tail if the
+// list was empty.
 func (l *LinkedList[T]) InsertFirst(value T) {
 	x := &Node[T]{
 		Value: value,
@@ -46,12 +47,15 @@ func (l *LinkedList[T]) InsertFirst(value T) {
 	}
 	if l.Head != nil {
 		l.Head.Prev = x
+	} else {
+		l.Tail = x
 	}
 	l.Head = x
 }
 
 // InsertLast inserts a new value at the end of the list.
-// The new node becomes the tail of the list.
+// The new node becomes the tail of the list, and also its head if the
+// list was empty.
 func (l *LinkedList[T]) InsertLast(value T) {
 	x := &Node[T]{
 		Value: value,
@@ -60,6 +64,8 @@ func (l *LinkedList[T]) InsertLast(value T) {
 	}
 	if l.Tail != nil {
 		l.Tail.Next = x
+	} else {
+		l.Head = x
 	}
 	l.Tail = x
 }
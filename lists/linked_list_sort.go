@@ -0,0 +1,74 @@
+package lists
+
+// InsertionSort sorts the list in ascending order as determined by the
+// cmp function, using the insertion sort algorithm.
+//
+// Nodes are detached from the front of the list one at a time and
+// re-linked into a sorted list that is built in place. No values are
+// copied, so pointers to nodes held by the caller stay valid.
+//
+// Each node is inserted after the last node that is not greater than it,
+// found by walking back from the tail. This keeps the sort stable and
+// makes it run in O(n) time on a list that is already sorted.
+//
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b, as in slices.SortFunc.
+//
+// Time complexity: O(n²)
+// Space complexity: O(1)
+func (l *LinkedList[T]) InsertionSort(cmp func(a, b T) int) {
+	x := l.Head
+	l.Head = nil
+	l.Tail = nil
+
+	for x != nil {
+		next := x.Next
+		l.insertNodeSorted(x, cmp)
+		x = next
+	}
+}
+
+// InsertSorted inserts a new value into a list that is sorted in
+// ascending order as determined by the cmp function, keeping it sorted.
+//
+// The value is placed after any values that compare equal to it, so a
+// list built entirely with InsertSorted keeps items in arrival order
+// among equals. The search starts at the tail, which makes inserting
+// items that arrive in nearly sorted order cheap.
+//
+// Time complexity: O(n) in the worst case, O(1) if value belongs at the tail
+// Space complexity: O(1)
+func (l *LinkedList[T]) InsertSorted(value T, cmp func(a, b T) int) {
+	l.insertNodeSorted(&Node[T]{Value: value}, cmp)
+}
+
+// insertNodeSorted links the detached node x into the sorted list right
+// after the last node whose value is not greater than x.Value.
+func (l *LinkedList[T]) insertNodeSorted(x *Node[T], cmp func(a, b T) int) {
+	y := l.Tail
+	for y != nil && cmp(y.Value, x.Value) > 0 {
+		y = y.Prev
+	}
+
+	if y == nil {
+		// x is smaller than every node: it becomes the new head.
+		x.Prev = nil
+		x.Next = l.Head
+		if l.Head != nil {
+			l.Head.Prev = x
+		} else {
+			l.Tail = x
+		}
+		l.Head = x
+		return
+	}
+
+	x.Prev = y
+	x.Next = y.Next
+	if y.Next != nil {
+		y.Next.Prev = x
+	} else {
+		l.Tail = x
+	}
+	y.Next = x
+}
@@ -0,0 +1,91 @@
+package lists
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// values returns the values of l from head to tail, checking that the
+// Prev links mirror the Next links.
+func values[T comparable](t *testing.T, l *LinkedList[T]) []T {
+	t.Helper()
+	var out []T
+	var prev *Node[T]
+	for x := l.Head; x != nil; x = x.Next {
+		if x.Prev != prev {
+			t.Fatalf("broken Prev link at %v", x.Value)
+		}
+		out = append(out, x.Value)
+		prev = x
+	}
+	if l.Tail != prev {
+		t.Fatalf("Tail is %v, want the last node %v", l.Tail, prev)
+	}
+	return out
+}
+
+func TestLinkedListInsertionSort(t *testing.T) {
+	for _, in := range [][]int{
+		{},
+		{1},
+		{2, 1},
+		{1, 2, 3, 4},
+		{4, 3, 2, 1},
+		{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5},
+	} {
+		l := NewLinkedList[int]()
+		var nodes []*Node[int]
+		for _, x := range in {
+			l.InsertLast(x)
+			nodes = append(nodes, l.Tail)
+		}
+
+		l.InsertionSort(cmp.Compare[int])
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if got := values(t, l); !slices.Equal(got, want) {
+			t.Errorf("InsertionSort(%v) = %v, want %v", in, got, want)
+		}
+
+		// The same nodes must still be in the list.
+		for _, x := range nodes {
+			found := false
+			for y := l.Head; y != nil; y = y.Next {
+				found = found || x == y
+			}
+			if !found {
+				t.Errorf("InsertionSort(%v) lost node %v", in, x.Value)
+			}
+		}
+	}
+}
+
+func TestLinkedListInsertionSortStable(t *testing.T) {
+	type item struct{ key, id int }
+	byKey := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+
+	l := NewLinkedList[item]()
+	for i, k := range []int{2, 1, 2, 0, 1, 2, 0} {
+		l.InsertLast(item{k, i})
+	}
+	l.InsertionSort(byKey)
+
+	got := values(t, l)
+	want := []item{{0, 3}, {0, 6}, {1, 1}, {1, 4}, {2, 0}, {2, 2}, {2, 5}}
+	if !slices.Equal(got, want) {
+		t.Errorf("InsertionSort = %v, want %v", got, want)
+	}
+}
+
+func TestLinkedListInsertSorted(t *testing.T) {
+	l := NewLinkedList[string]()
+	for _, s := range []string{"m", "c", "x", "a", "m", "z"} {
+		l.InsertSorted(s, cmp.Compare[string])
+	}
+	want := []string{"a", "c", "m", "m", "x", "z"}
+	if got := values(t, l); !slices.Equal(got, want) {
+		t.Errorf("InsertSorted built %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,4 @@
+// Package matrix implements the square matrix multiplication algorithms
+// of CLRS Chapter 4: the standard triple loop, the simple
+// divide-and-conquer algorithm and Strassen's method.
+package matrix
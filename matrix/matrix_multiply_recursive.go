@@ -0,0 +1,46 @@
+package matrix
+
+// MultiplyRecursive multiplies two n×n integer matrices A and B
+// using the classical divide-and-conquer (recursive) algorithm.
+//
+// It divides A and B into four n/2×n/2 submatrices and recursively
+// computes each quadrant of the result matrix C as follows:
+//
+//	C11 = A11×B11 + A12×B21
+//	C12 = A11×B12 + A12×B22
+//	C21 = A21×B11 + A22×B21
+//	C22 = A21×B12 + A22×B22
+//
+// If n is not a power of two, A and B are first padded with zeros to the
+// next power of two. MultiplyRecursive panics unless A and B are both n×n.
+//
+// Time complexity: Θ(n³)
+// Space complexity: Θ(n²)
+func MultiplyRecursive(A, B [][]int) [][]int {
+	return powerOfTwoMultiply(A, B, multiplyRecursive)
+}
+
+// multiplyRecursive is MultiplyRecursive for n a power of two.
+func multiplyRecursive(A, B [][]int) [][]int {
+	n := len(A)
+	C := MakeMatrix(n)
+
+	// Base case: single element
+	if n == 1 {
+		C[0][0] = A[0][0] * B[0][0]
+		return C
+	}
+
+	// Divide matrices into four submatrices each
+	A11, A12, A21, A22 := SplitMatrix(A)
+	B11, B12, B21, B22 := SplitMatrix(B)
+
+	// Recursive calls for submatrix multiplication and addition
+	C11 := AddMatrix(multiplyRecursive(A11, B11), multiplyRecursive(A12, B21))
+	C12 := AddMatrix(multiplyRecursive(A11, B12), multiplyRecursive(A12, B22))
+	C21 := AddMatrix(multiplyRecursive(A21, B11), multiplyRecursive(A22, B21))
+	C22 := AddMatrix(multiplyRecursive(A21, B12), multiplyRecursive(A22, B22))
+
+	// Combine submatrices into the final result
+	return CombineMatrix(C11, C12, C21, C22)
+}
@@ -1,11 +1,11 @@
-package main
+package matrix
 
-// StrassenMatrixMultiply multiplies two n×n integer matrices A and B
+// MultiplyStrassen multiplies two n×n integer matrices A and B
 // using Strassen’s divide-and-conquer algorithm.
 //
 // This algorithm improves on the classical recursive method by reducing
 // the number of recursive multiplications from 8 to 7, at the cost of a few
-// extra additions and subtractions of submatrices. It assumes n is a power of two.
+// extra additions and subtractions of submatrices.
 //
 // The computation is based on the following intermediate matrices:
 //
@@ -24,9 +24,17 @@ package main
 //	C21 = M2 + M4
 //	C22 = M1 − M2 + M3 + M6
 //
+// If n is not a power of two, A and B are first padded with zeros to the
+// next power of two. MultiplyStrassen panics unless A and B are both n×n.
+//
 // Time complexity: Θ(n^2.81)
 // Space complexity: Θ(n²)
-func StrassenMatrixMultiply(A, B [][]int) [][]int {
+func MultiplyStrassen(A, B [][]int) [][]int {
+	return powerOfTwoMultiply(A, B, multiplyStrassen)
+}
+
+// multiplyStrassen is MultiplyStrassen for n a power of two.
+func multiplyStrassen(A, B [][]int) [][]int {
 	n := len(A)
 	C := MakeMatrix(n)
 	if n == 1 {
@@ -37,13 +45,13 @@ func StrassenMatrixMultiply(A, B [][]int) [][]int {
 	A11, A12, A21, A22 := SplitMatrix(A)
 	B11, B12, B21, B22 := SplitMatrix(B)
 
-	M1 := StrassenMatrixMultiply(AddMatrix(A11, A22), AddMatrix(B11, B22))
-	M2 := StrassenMatrixMultiply(AddMatrix(A21, A22), B11)
-	M3 := StrassenMatrixMultiply(A11, SubMatrix(B12, B22))
-	M4 := StrassenMatrixMultiply(A22, SubMatrix(B21, B11))
-	M5 := StrassenMatrixMultiply(AddMatrix(A11, A12), B22)
-	M6 := StrassenMatrixMultiply(SubMatrix(A21, A11), AddMatrix(B11, B12))
-	M7 := StrassenMatrixMultiply(SubMatrix(A12, A22), AddMatrix(B21, B22))
+	M1 := multiplyStrassen(AddMatrix(A11, A22), AddMatrix(B11, B22))
+	M2 := multiplyStrassen(AddMatrix(A21, A22), B11)
+	M3 := multiplyStrassen(A11, SubMatrix(B12, B22))
+	M4 := multiplyStrassen(A22, SubMatrix(B21, B11))
+	M5 := multiplyStrassen(AddMatrix(A11, A12), B22)
+	M6 := multiplyStrassen(SubMatrix(A21, A11), AddMatrix(B11, B12))
+	M7 := multiplyStrassen(SubMatrix(A12, A22), AddMatrix(B21, B22))
 
 	C11 := AddMatrix(SubMatrix(AddMatrix(M1, M4), M5), M7)
 	C12 := AddMatrix(M3, M5)
@@ -0,0 +1,102 @@
+package matrix
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// multiplications lists the three multiplication algorithms behind a
+// common signature.
+var multiplications = []struct {
+	name     string
+	multiply func(A, B [][]int) [][]int
+}{
+	{"Multiply", func(A, B [][]int) [][]int {
+		C := MakeMatrix(len(A))
+		Multiply(A, B, C, len(A))
+		return C
+	}},
+	{"MultiplyRecursive", MultiplyRecursive},
+	{"MultiplyStrassen", MultiplyStrassen},
+}
+
+// randomMatrix returns an n x n matrix of pseudo-random ints in [-9, 9].
+func randomMatrix(rng *rand.Rand, n int) [][]int {
+	M := MakeMatrix(n)
+	for i := range M {
+		for j := range M[i] {
+			M[i][j] = rng.Intn(19) - 9
+		}
+	}
+	return M
+}
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		name string
+		A, B [][]int
+		want [][]int
+	}{
+		{"0x0", [][]int{}, [][]int{}, [][]int{}},
+		{"1x1", [][]int{{3}}, [][]int{{-4}}, [][]int{{-12}}},
+		{"2x2", [][]int{{1, 3}, {7, 5}}, [][]int{{6, 8}, {4, 2}}, [][]int{{18, 14}, {62, 66}}},
+		{"3x3",
+			[][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			[][]int{{9, 8, 7}, {6, 5, 4}, {3, 2, 1}},
+			[][]int{{30, 24, 18}, {84, 69, 54}, {138, 114, 90}}},
+		{"4x4 identity",
+			[][]int{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}},
+			[][]int{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}},
+			[][]int{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}}},
+		{"5x5 diagonal",
+			[][]int{{2, 0, 0, 0, 0}, {0, 2, 0, 0, 0}, {0, 0, 2, 0, 0}, {0, 0, 0, 2, 0}, {0, 0, 0, 0, 2}},
+			[][]int{{1, 1, 1, 1, 1}, {2, 2, 2, 2, 2}, {3, 3, 3, 3, 3}, {4, 4, 4, 4, 4}, {5, 5, 5, 5, 5}},
+			[][]int{{2, 2, 2, 2, 2}, {4, 4, 4, 4, 4}, {6, 6, 6, 6, 6}, {8, 8, 8, 8, 8}, {10, 10, 10, 10, 10}}},
+	}
+	for _, m := range multiplications {
+		for _, tt := range tests {
+			if got := m.multiply(tt.A, tt.B); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s(%s) = %v, want %v", m.name, tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestMultiplyAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for n := 1; n <= 17; n++ {
+		A, B := randomMatrix(rng, n), randomMatrix(rng, n)
+		want := multiplications[0].multiply(A, B)
+		for _, m := range multiplications[1:] {
+			if got := m.multiply(A, B); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s differs from Multiply on %dx%d matrices", m.name, n, n)
+			}
+		}
+	}
+}
+
+func TestMultiplyMismatchedDimensions(t *testing.T) {
+	tests := []struct {
+		name string
+		A, B [][]int
+	}{
+		{"2x2 by 3x3", MakeMatrix(2), MakeMatrix(3)},
+		{"3x3 by 2x2", MakeMatrix(3), MakeMatrix(2)},
+		{"2x3 by 3x2", [][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"ragged", [][]int{{1, 2}, {3}}, MakeMatrix(2)},
+	}
+	for _, m := range multiplications {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", m.name, tt.name), func(t *testing.T) {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("%s(%s) did not panic", m.name, tt.name)
+					}
+				}()
+				m.multiply(tt.A, tt.B)
+			})
+		}
+	}
+}
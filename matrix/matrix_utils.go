@@ -0,0 +1,131 @@
+package matrix
+
+// MakeMatrix returns a new n x n matrix of zeros.
+func MakeMatrix(n int) [][]int {
+	m := make([][]int, n)
+	for i := range m {
+		m[i] = make([]int, n)
+	}
+	return m
+}
+
+// AddMatrix returns A + B for two n x n matrices.
+//
+// Time complexity: Θ(n²)
+func AddMatrix(A, B [][]int) [][]int {
+	n := len(A)
+	C := MakeMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			C[i][j] = A[i][j] + B[i][j]
+		}
+	}
+	return C
+}
+
+// SubMatrix returns A - B for two n x n matrices.
+//
+// Time complexity: Θ(n²)
+func SubMatrix(A, B [][]int) [][]int {
+	n := len(A)
+	C := MakeMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			C[i][j] = A[i][j] - B[i][j]
+		}
+	}
+	return C
+}
+
+// SplitMatrix partitions an n x n matrix, n even, into its four
+// n/2 x n/2 quadrants A11, A12, A21 and A22 (CLRS equation 4.9).
+// The quadrants are copies, not views of A.
+//
+// Time complexity: Θ(n²)
+func SplitMatrix(A [][]int) ([][]int, [][]int, [][]int, [][]int) {
+	n := len(A)
+	k := n / 2
+	A11 := MakeMatrix(k)
+	A12 := MakeMatrix(k)
+	A21 := MakeMatrix(k)
+	A22 := MakeMatrix(k)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			A11[i][j] = A[i][j]
+			A12[i][j] = A[i][j+k]
+			A21[i][j] = A[i+k][j]
+			A22[i][j] = A[i+k][j+k]
+		}
+	}
+	return A11, A12, A21, A22
+}
+
+// CombineMatrix is the inverse of SplitMatrix: it assembles four
+// k x k quadrants into one 2k x 2k matrix.
+//
+// Time complexity: Θ(n²)
+func CombineMatrix(C11, C12, C21, C22 [][]int) [][]int {
+	k := len(C11)
+	n := k * 2
+	C := MakeMatrix(n)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			C[i][j] = C11[i][j]
+			C[i][j+k] = C12[i][j]
+			C[i+k][j] = C21[i][j]
+			C[i+k][j+k] = C22[i][j]
+		}
+	}
+	return C
+}
+
+// checkSquare panics unless every matrix in Ms is n x n.
+func checkSquare(n int, Ms ...[][]int) {
+	for _, M := range Ms {
+		if len(M) != n {
+			panic("matrix: operands are not n x n matrices of the same n")
+		}
+		for _, row := range M {
+			if len(row) != n {
+				panic("matrix: operands are not n x n matrices of the same n")
+			}
+		}
+	}
+}
+
+// padded returns A unchanged if its size is already m, and otherwise a
+// copy of A in the top-left corner of an m x m matrix of zeros.
+func padded(A [][]int, m int) [][]int {
+	if len(A) == m {
+		return A
+	}
+	P := MakeMatrix(m)
+	for i, row := range A {
+		copy(P[i], row)
+	}
+	return P
+}
+
+// powerOfTwoMultiply runs multiply, which only handles sizes that are
+// powers of two, on two n x n matrices of any size n by padding them
+// with zeros up to the next power of two and trimming the product back
+// to n x n (CLRS Exercise 4.2-3). Padding at most doubles n, so it does
+// not change the asymptotic running time.
+func powerOfTwoMultiply(A, B [][]int, multiply func(A, B [][]int) [][]int) [][]int {
+	n := len(A)
+	checkSquare(n, A, B)
+	if n == 0 {
+		return MakeMatrix(0)
+	}
+
+	m := 1
+	for m < n {
+		m *= 2
+	}
+	C := multiply(padded(A, m), padded(B, m))
+	C = C[:n]
+	for i := range C {
+		C[i] = C[i][:n:n]
+	}
+	return C
+}
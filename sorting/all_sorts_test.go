@@ -0,0 +1,262 @@
+package sorting
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// Every sort in this package, for the harness in sortcheck_test.go.
+var (
+	funcSorts = []funcSort{
+		{"InsertionSortFunc", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			InsertionSortFunc(A, cmp)
+			return A
+		}},
+		{"BinaryInsertionSortFunc", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			BinaryInsertionSortFunc(A, cmp)
+			return A
+		}},
+		{"MergeSortFunc", true, MergeSortFunc[elem]},
+		{"MergeSortBufferedFunc", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			MergeSortBufferedFunc(A, nil, MergeSortOptions{}, cmp)
+			return A
+		}},
+		{"MergeSortBufferedFunc/cutoff", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			MergeSortBufferedFunc(A, nil, MergeSortOptions{Cutoff: 8}, cmp)
+			return A
+		}},
+		{"MergeSortBufferedFunc/bottom-up", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			MergeSortBufferedFunc(A, nil, MergeSortOptions{BottomUp: true, Cutoff: 5}, cmp)
+			return A
+		}},
+		{"ParallelMergeSortFunc", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			return ParallelMergeSortFunc(A, ParallelMergeSortOptions{Grain: 16}, cmp)
+		}},
+		{"MergeSortCountInversionsFunc", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			sorted, _ := MergeSortCountInversionsFunc(A, cmp)
+			return sorted
+		}},
+		{"TimSortFunc", true, func(A []elem, cmp func(a, b elem) int) []elem {
+			TimSortFunc(A, cmp)
+			return A
+		}},
+		{"CountingSortByKey", true, func(A []elem, _ func(a, b elem) int) []elem {
+			return CountingSortByKey(A, func(e elem) int { return e.key })
+		}},
+		{"HeapSortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			HeapSortFunc(A, cmp)
+			return A
+		}},
+		{"QuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			QuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}},
+		{"RandomizedQuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			RandomizedQuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}},
+		{"TailRecursiveQuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			TailRecursiveQuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}},
+		{"IterativeQuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			IterativeQuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}},
+		{"ParallelQuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			opts := ParallelQuicksortOptions{Grain: 16, Cutoff: 4, Workers: 4}
+			ParallelQuicksortFunc(A, 0, len(A)-1, opts, cmp)
+			return A
+		}},
+		{"ParallelQuicksortFunc/hoare", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			opts := ParallelQuicksortOptions{Grain: 16, Cutoff: 1, Workers: 4, Scheme: Hoare}
+			ParallelQuicksortFunc(A, 0, len(A)-1, opts, cmp)
+			return A
+		}},
+		{"ParallelQuicksortFunc/three-way", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			opts := ParallelQuicksortOptions{Grain: 16, Cutoff: 1, Workers: 4, Scheme: ThreeWay}
+			ParallelQuicksortFunc(A, 0, len(A)-1, opts, cmp)
+			return A
+		}},
+		{"DualPivotQuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			DualPivotQuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}},
+		{"RandomizedDualPivotQuicksortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			RandomizedDualPivotQuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}},
+		{"RandomizedDualPivotQuicksortWithFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			RandomizedDualPivotQuicksortWithFunc(A, 0, len(A)-1, QuicksortOptions{Seeded: true, Seed: 1}, cmp)
+			return A
+		}},
+		{"IntrosortFunc", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			IntrosortFunc(A, cmp)
+			return A
+		}},
+		{"QuicksortWithFunc/hoare", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			QuicksortWithFunc(A, 0, len(A)-1, QuicksortOptions{Scheme: Hoare}, cmp)
+			return A
+		}},
+		{"QuicksortWithFunc/three-way", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			QuicksortWithFunc(A, 0, len(A)-1, QuicksortOptions{Scheme: ThreeWay}, cmp)
+			return A
+		}},
+		{"RandomizedQuicksortWithFunc/lomuto", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			RandomizedQuicksortWithFunc(A, 0, len(A)-1, QuicksortOptions{Scheme: Lomuto}, cmp)
+			return A
+		}},
+		{"RandomizedQuicksortWithFunc/hoare", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			RandomizedQuicksortWithFunc(A, 0, len(A)-1, QuicksortOptions{Scheme: Hoare}, cmp)
+			return A
+		}},
+		{"RandomizedQuicksortWithFunc/three-way", false, func(A []elem, cmp func(a, b elem) int) []elem {
+			RandomizedQuicksortWithFunc(A, 0, len(A)-1, QuicksortOptions{Scheme: ThreeWay}, cmp)
+			return A
+		}},
+	}
+
+	intSorts = []intSort{
+		{"InsertionSort", func(A []int) []int { InsertionSort(A); return A }},
+		{"BinaryInsertionSort", func(A []int) []int { BinaryInsertionSort(A); return A }},
+		{"MergeSort", MergeSort[int]},
+		{"TimSort", func(A []int) []int { TimSort(A); return A }},
+		{"InsertionSortWithStats", func(A []int) []int { InsertionSortWithStats(A); return A }},
+		{"MergeSortWithStats", func(A []int) []int { sorted, _ := MergeSortWithStats(A); return sorted }},
+		{"InsertionSortTraced", func(A []int) []int {
+			InsertionSortTraced(A, TraceFunc[int](func(Event[int]) {}))
+			return A
+		}},
+		{"MergeSortTraced", func(A []int) []int {
+			return MergeSortTraced(A, TraceFunc[int](func(Event[int]) {}))
+		}},
+		{"CountingSort", CountingSort},
+		{"RadixSortInt64", radixSortInts(RadixSortOptions{})},
+		{"RadixSortInt64/3-bit", radixSortInts(RadixSortOptions{DigitBits: 3})},
+		{"RadixSortInt64/16-bit", radixSortInts(RadixSortOptions{DigitBits: 16})},
+		{"RadixSortUint64", radixSortIntsUnsigned(RadixSortOptions{DigitBits: 5})},
+		{"RadixSortStrings", radixSortIntsAsStrings(RadixSortOptions{})},
+		{"RadixSortStrings/2-byte", radixSortIntsAsStrings(RadixSortOptions{DigitBits: 16})},
+		{"BucketSort", bucketSortInts},
+		{"HeapSort", func(A []int) []int { HeapSort(A); return A }},
+		{"HeapSortWithStats", func(A []int) []int { HeapSortWithStats(A); return A }},
+		{"HeapSortTraced", func(A []int) []int {
+			HeapSortTraced(A, TraceFunc[int](func(Event[int]) {}))
+			return A
+		}},
+		{"Quicksort", func(A []int) []int { Quicksort(A, 0, len(A)-1); return A }},
+		{"RandomizedQuicksort", func(A []int) []int { RandomizedQuicksort(A, 0, len(A)-1); return A }},
+		{"TailRecursiveQuicksort", func(A []int) []int { TailRecursiveQuicksort(A, 0, len(A)-1); return A }},
+		{"IterativeQuicksort", func(A []int) []int { IterativeQuicksort(A, 0, len(A)-1); return A }},
+		{"ParallelQuicksort", func(A []int) []int {
+			ParallelQuicksort(A, 0, len(A)-1, ParallelQuicksortOptions{})
+			return A
+		}},
+		{"DualPivotQuicksort", func(A []int) []int { DualPivotQuicksort(A, 0, len(A)-1); return A }},
+		{"RandomizedDualPivotQuicksort", func(A []int) []int {
+			RandomizedDualPivotQuicksort(A, 0, len(A)-1)
+			return A
+		}},
+		{"Introsort", func(A []int) []int { Introsort(A); return A }},
+		{"QuicksortWithStats", func(A []int) []int { QuicksortWithStats(A, 0, len(A)-1); return A }},
+		{"RandomizedQuicksortWithStats", func(A []int) []int {
+			RandomizedQuicksortWithStats(A, 0, len(A)-1)
+			return A
+		}},
+		{"QuicksortTraced", func(A []int) []int {
+			QuicksortTraced(A, 0, len(A)-1, TraceFunc[int](func(Event[int]) {}))
+			return A
+		}},
+		{"RandomizedQuicksortTraced", func(A []int) []int {
+			RandomizedQuicksortTraced(A, 0, len(A)-1, TraceFunc[int](func(Event[int]) {}))
+			return A
+		}},
+	}
+)
+
+// The sorts below only take one kind of key. Each is run on the harness
+// ints through an order-preserving encoding, and the result decoded.
+
+func radixSortInts(opts RadixSortOptions) func(A []int) []int {
+	return func(A []int) []int {
+		B := make([]int64, len(A))
+		for i, x := range A {
+			B[i] = int64(x)
+		}
+		RadixSortInt64(B, opts)
+		for i, x := range B {
+			A[i] = int(x)
+		}
+		return A
+	}
+}
+
+// radixSortIntsUnsigned flips the sign bit, as RadixSortInt64 does.
+func radixSortIntsUnsigned(opts RadixSortOptions) func(A []int) []int {
+	return func(A []int) []int {
+		B := make([]uint64, len(A))
+		for i, x := range A {
+			B[i] = uint64(x) ^ 1<<63
+		}
+		RadixSortUint64(B, opts)
+		for i, x := range B {
+			A[i] = int(x ^ 1<<63)
+		}
+		return A
+	}
+}
+
+// radixSortIntsAsStrings encodes every key as its length in bytes
+// followed by its big-endian bytes without leading zeros, so that the
+// strings have different lengths.
+func radixSortIntsAsStrings(opts RadixSortOptions) func(A []int) []int {
+	return func(A []int) []int {
+		S := make([]string, len(A))
+		for i, x := range A {
+			u := uint64(x) ^ 1<<63
+			var b []byte
+			for ; u > 0; u >>= 8 {
+				b = append([]byte{byte(u)}, b...)
+			}
+			S[i] = string(append([]byte{byte(len(b))}, b...))
+		}
+		RadixSortStrings(S, opts)
+		for i, s := range S {
+			var u uint64
+			for _, c := range []byte(s[1:]) {
+				u = u<<8 | uint64(c)
+			}
+			A[i] = int(u ^ 1<<63)
+		}
+		return A
+	}
+}
+
+// bucketSortInts scales the keys into [0, 1).
+func bucketSortInts(A []int) []int {
+	if len(A) == 0 {
+		return A
+	}
+	lo, hi := slices.Min(A), slices.Max(A)
+	span := float64(hi - lo + 1)
+	B := make([]float64, len(A))
+	for i, x := range A {
+		B[i] = float64(x-lo) / span
+	}
+	if err := BucketSort(B); err != nil {
+		panic(err)
+	}
+	for i, x := range B {
+		A[i] = lo + int(math.Round(x*span))
+	}
+	return A
+}
+
+func TestAllSorts(t *testing.T) {
+	runSortHarness(t, funcSorts, intSorts)
+}
+
+func FuzzSorts(f *testing.F) {
+	fuzzSorts(f, funcSorts, intSorts)
+}
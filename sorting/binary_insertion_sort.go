@@ -0,0 +1,41 @@
+package sorting
+
+import "cmp"
+
+// BinaryInsertionSort sorts the given slice in ascending order using
+// insertion sort with a binary search for each insertion point.
+//
+// InsertionSort finds the place for A[i] by scanning A[0..i-1] from the
+// right, comparing as it shifts. Since A[0..i-1] is already sorted, the
+// place can instead be found by binary search, cutting the comparisons
+// to O(n log n). Elements still have to be shifted one position to the
+// right, so the number of moves is unchanged.
+//
+// The sort is stable: the search finds the position after any elements
+// equal to the key.
+//
+// Time complexity: O(n²), with O(n log n) comparisons and O(n²) moves
+// Space complexity: O(1)
+func BinaryInsertionSort[T cmp.Ordered](A []T) {
+	BinaryInsertionSortFunc(A, cmp.Compare[T])
+}
+
+// BinaryInsertionSortFunc is BinaryInsertionSort with elements ordered
+// by the cmp function.
+func BinaryInsertionSortFunc[T any](A []T, cmp func(a, b T) int) {
+	binaryInsertionSortFrom(A, 1, cmp)
+}
+
+// binaryInsertionSortFrom sorts A given that A[:start] is already sorted.
+func binaryInsertionSortFrom[T any](A []T, start int, cmp func(a, b T) int) {
+	for i := max(start, 1); i < len(A); i++ {
+		key := A[i]
+
+		// Find the position after the last element <= key.
+		pos := upperBound(A[:i], key, cmp)
+
+		// Shift A[pos..i-1] one position to the right and insert key.
+		copy(A[pos+1:i+1], A[pos:i])
+		A[pos] = key
+	}
+}
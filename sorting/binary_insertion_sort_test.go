@@ -0,0 +1,46 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBinaryInsertionSort(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(500)) {
+		got := slices.Clone(in)
+		BinaryInsertionSort(got)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("BinaryInsertionSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestBinaryInsertionSortFuncStable(t *testing.T) {
+	R := records(2, 1, 2, 0, 1, 2, 0, 0, 1)
+	BinaryInsertionSortFunc(R, byKey)
+	if !slices.IsSortedFunc(R, byKey) || !isStable(R) {
+		t.Errorf("BinaryInsertionSortFunc is not stable: %v", R)
+	}
+}
+
+func TestBinaryInsertionSortComparisons(t *testing.T) {
+	// Reversed input is InsertionSort's worst case: n(n-1)/2 comparisons.
+	const n = 1024
+	A := make([]int, n)
+	for i := range A {
+		A[i] = n - i
+	}
+
+	comparisons := 0
+	BinaryInsertionSortFunc(A, func(a, b int) int {
+		comparisons++
+		return a - b
+	})
+	// Each insertion into i sorted elements costs at most ⌈lg(i+1)⌉.
+	if limit := n * 10; comparisons > limit {
+		t.Errorf("BinaryInsertionSortFunc made %d comparisons, want at most %d", comparisons, limit)
+	}
+}
@@ -0,0 +1,45 @@
+package sorting
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrKeyOutOfRange is returned by BucketSort for a key outside [0, 1).
+var ErrKeyOutOfRange = errors.New("key out of range")
+
+// BucketSort sorts the given slice of floats in [0, 1) in ascending
+// order using the BUCKET-SORT algorithm from CLRS.
+//
+// The interval [0, 1) is divided into n equal buckets, every key x goes
+// into bucket ⌊n·x⌋, each bucket is sorted with InsertionSort, and the
+// buckets are concatenated back into A. When the keys are drawn
+// uniformly from [0, 1), every bucket holds O(1) keys on average.
+//
+// If any key lies outside [0, 1) or is NaN, A is left unchanged and an
+// error wrapping ErrKeyOutOfRange is returned.
+//
+// Time complexity: Θ(n) on average for uniform keys, Θ(n²) worst case
+// Space complexity: Θ(n)
+func BucketSort(A []float64) error {
+	for i, x := range A {
+		if !(x >= 0 && x < 1) {
+			return fmt.Errorf("bucket sort key %v at index %d: %w", x, i, ErrKeyOutOfRange)
+		}
+	}
+
+	n := len(A)
+	B := make([][]float64, n)
+	for _, x := range A {
+		// n·x can round up to n for x just below 1.
+		i := min(int(float64(n)*x), n-1)
+		B[i] = append(B[i], x)
+	}
+
+	k := 0
+	for _, bucket := range B {
+		InsertionSort(bucket)
+		k += copy(A[k:], bucket)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package sorting
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBucketSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	inputs := [][]float64{
+		{},
+		{0.5},
+		{0.78, 0.17, 0.39, 0.26, 0.72, 0.94, 0.21, 0.12, 0.23, 0.68},
+		{0, 0, math.Nextafter(1, 0), 0.5, 0.5},
+	}
+	uniform := make([]float64, 1000)
+	for i := range uniform {
+		uniform[i] = rng.Float64()
+	}
+	inputs = append(inputs, uniform)
+
+	for _, in := range inputs {
+		got := slices.Clone(in)
+		if err := BucketSort(got); err != nil {
+			t.Fatalf("BucketSort(%v): %v", in, err)
+		}
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("BucketSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestBucketSortOutOfRange(t *testing.T) {
+	for _, bad := range []float64{1, -0.1, math.NaN(), math.Inf(1)} {
+		A := []float64{0.3, bad, 0.1}
+		err := BucketSort(A)
+		if !errors.Is(err, ErrKeyOutOfRange) {
+			t.Errorf("BucketSort with key %v: err = %v, want ErrKeyOutOfRange", bad, err)
+		}
+		if A[0] != 0.3 || A[2] != 0.1 {
+			t.Errorf("BucketSort with key %v modified its input: %v", bad, A)
+		}
+	}
+}
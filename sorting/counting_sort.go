@@ -0,0 +1,72 @@
+package sorting
+
+import "math"
+
+// CountingSort returns a sorted copy of the given slice of integers using
+// the COUNTING-SORT algorithm from CLRS.
+//
+// CLRS assumes keys in the range 0 to k. Here the range is taken from
+// the input instead: every key is offset by the smallest one, so
+// negative keys are sorted as well, and k is max(A) - min(A). The sort
+// allocates k+1 counters, so it pays off only when k = O(n); for wider
+// keys use RadixSortInt64.
+//
+// Time complexity: Θ(n + k)
+// Space complexity: Θ(n + k)
+func CountingSort(A []int) []int {
+	return CountingSortByKey(A, func(x int) int { return x })
+}
+
+// CountingSortByKey returns a copy of the given slice sorted by the
+// integer key of each element, using the COUNTING-SORT algorithm.
+//
+// The sort is stable: elements with equal keys keep their relative
+// order. This is what makes counting sort usable as the digit sort of
+// RadixSort.
+//
+// Besides the n-element result, the sort allocates one int counter per
+// value in the key range, k+1 of them: O(n+k) memory, so keys spanning
+// 2^30 values already need 8 GiB of counters. It panics if the range has
+// more values than an int can count, as for keys math.MinInt and
+// math.MaxInt.
+//
+// Time complexity: Θ(n + k) for keys spanning a range of k+1 values
+// Space complexity: Θ(n + k)
+func CountingSortByKey[T any](A []T, key func(T) int) []T {
+	B := make([]T, len(A))
+	if len(A) == 0 {
+		return B
+	}
+
+	lo, hi := key(A[0]), key(A[0])
+	for _, x := range A[1:] {
+		k := key(x)
+		lo = min(lo, k)
+		hi = max(hi, k)
+	}
+
+	// hi-lo wraps around to a negative number if the keys span more
+	// than math.MaxInt, and hi-lo+1 does if they span exactly that.
+	if k := hi - lo; k < 0 || k == math.MaxInt {
+		panic("counting sort: key range too wide")
+	}
+
+	// C[i] counts the elements whose key is lo+i ...
+	C := make([]int, hi-lo+1)
+	for _, x := range A {
+		C[key(x)-lo]++
+	}
+	// ... and then the elements whose key is at most lo+i.
+	for i := 1; i < len(C); i++ {
+		C[i] += C[i-1]
+	}
+
+	// Place the elements from the right, so that equal keys keep their
+	// order.
+	for j := len(A) - 1; j >= 0; j-- {
+		i := key(A[j]) - lo
+		C[i]--
+		B[C[i]] = A[j]
+	}
+	return B
+}
@@ -0,0 +1,53 @@
+package sorting
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCountingSort(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(500)) {
+		got := CountingSort(in)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("CountingSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestCountingSortByKeyStable(t *testing.T) {
+	R := records(2, -1, 2, 0, -1, 2, 0, 0, -1)
+	got := CountingSortByKey(R, func(r record) int { return r.key })
+	if !slices.IsSortedFunc(got, byKey) || !isStable(got) {
+		t.Errorf("CountingSortByKey is not stable: %v", got)
+	}
+}
+
+func TestCountingSortKeyRangeOverflow(t *testing.T) {
+	for _, in := range [][]int{
+		{math.MaxInt, math.MinInt},
+		{math.MaxInt, 0, -1},
+		{math.MaxInt - 1, math.MinInt},
+		{math.MaxInt, 0}, // hi-lo fits, hi-lo+1 does not
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != "counting sort: key range too wide" {
+					t.Errorf("CountingSort(%v) panicked with %v, want the key range error", in, r)
+				}
+			}()
+			CountingSort(in)
+		}()
+	}
+}
+
+func BenchmarkCountingSort(b *testing.B) {
+	in := randomInts(benchSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		CountingSort(in)
+	}
+}
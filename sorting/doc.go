@@ -0,0 +1,13 @@
+// Package sorting implements the sorting algorithms of CLRS Part II and
+// the exercises around them: insertion sort and merge sort (Chapter 2),
+// heapsort (Chapter 6), quicksort (Chapter 7) and the linear-time sorts
+// of Chapter 8, together with their variants, instrumented and traced
+// versions, and an external merge sort for data larger than memory. It
+// also implements the selection algorithms of Chapter 9, which find
+// medians and other order statistics without sorting.
+//
+// Every comparison sort takes cmp.Ordered elements and has a Func
+// counterpart that orders elements with a three-way comparison function
+// such as cmp.Compare. The linear-time sorts work on integer, string or
+// float keys instead.
+package sorting
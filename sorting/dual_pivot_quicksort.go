@@ -0,0 +1,176 @@
+package sorting
+
+import "cmp"
+
+// DualPivotQuicksort sorts A[p..r] in ascending order using Vladimir
+// Yaroslavskiy's dual-pivot quicksort.
+//
+// Each subarray is partitioned by DualPivotPartition around two pivots
+// into three parts, which are sorted recursively. The middle part needs
+// no sorting when the two pivots are equal, since it then holds only
+// keys equal to them; this keeps runs of equal keys from degrading the
+// sort.
+//
+// The pivots are A[p] and A[r], so already-sorted input is still a
+// worst case, as for Quicksort; RandomizedDualPivotQuicksort avoids it.
+//
+// The sort is not stable.
+//
+// Time complexity (average): O(n log n), with about 1.9n ln n
+// comparisons where Quicksort makes 2n ln n
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack on average
+func DualPivotQuicksort[T cmp.Ordered](A []T, p, r int) {
+	DualPivotQuicksortFunc(A, p, r, cmp.Compare[T])
+}
+
+// DualPivotQuicksortFunc sorts A[p..r] in ascending order as determined
+// by the cmp function, using dual-pivot quicksort.
+//
+// The sort is not stable.
+func DualPivotQuicksortFunc[T any](A []T, p, r int, cmp func(a, b T) int) {
+	dualPivotQuicksort(A, p, r, nil, cmp)
+}
+
+// RandomizedDualPivotQuicksort sorts A[p..r] in ascending order using
+// dual-pivot quicksort with both pivots chosen at random, so that no
+// input is consistently bad. The pivots come from the global source of
+// math/rand; RandomizedDualPivotQuicksortWith can seed them or replay
+// them.
+//
+// The sort is not stable.
+//
+// Time complexity (expected): O(n log n)
+// Time complexity (worst case): O(n²)     // highly unlikely due to random pivots
+// Space complexity: O(log n) recursion stack on average
+func RandomizedDualPivotQuicksort[T cmp.Ordered](A []T, p, r int) {
+	RandomizedDualPivotQuicksortFunc(A, p, r, cmp.Compare[T])
+}
+
+// RandomizedDualPivotQuicksortFunc sorts A[p..r] in ascending order as
+// determined by the cmp function, using randomized dual-pivot quicksort.
+//
+// The sort is not stable.
+func RandomizedDualPivotQuicksortFunc[T any](A []T, p, r int, cmp func(a, b T) int) {
+	dualPivotQuicksort(A, p, r, newPivotSource(QuicksortOptions{}), cmp)
+}
+
+// RandomizedDualPivotQuicksortWith sorts A[p..r] in ascending order
+// using randomized dual-pivot quicksort with the source of random pivots
+// selected by opts. opts.Scheme is ignored.
+//
+// Each partition chooses two pivots, the first from A[p..r] and the
+// second from A[p+1..r], so RecordPivots receives two indices per
+// partition.
+//
+// The sort is not stable.
+//
+// Time complexity (expected): O(n log n)
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack on average
+func RandomizedDualPivotQuicksortWith[T cmp.Ordered](A []T, p, r int, opts QuicksortOptions) {
+	RandomizedDualPivotQuicksortWithFunc(A, p, r, opts, cmp.Compare[T])
+}
+
+// RandomizedDualPivotQuicksortWithFunc is RandomizedDualPivotQuicksortWith
+// with elements ordered by the cmp function.
+func RandomizedDualPivotQuicksortWithFunc[T any](A []T, p, r int, opts QuicksortOptions, cmp func(a, b T) int) {
+	dualPivotQuicksort(A, p, r, newPivotSource(opts), cmp)
+}
+
+// dualPivotQuicksort is dual-pivot quicksort on A[p..r]. If pivots is
+// not nil, the pivots it chooses are first swapped into A[p] and A[r].
+func dualPivotQuicksort[T any](A []T, p, r int, pivots *pivotSource, cmp func(a, b T) int) {
+	if p >= r {
+		return
+	}
+
+	var lp, rp int
+	if pivots != nil {
+		lp, rp = randomizedDualPivotPartition(A, p, r, pivots, cmp)
+	} else {
+		lp, rp = DualPivotPartitionFunc(A, p, r, cmp)
+	}
+
+	dualPivotQuicksort(A, p, lp-1, pivots, cmp)
+	if cmp(A[lp], A[rp]) != 0 {
+		dualPivotQuicksort(A, lp+1, rp-1, pivots, cmp)
+	}
+	dualPivotQuicksort(A, rp+1, r, pivots, cmp)
+}
+
+// DualPivotPartition rearranges A[p..r], p < r, around the two pivots
+// x = min(A[p], A[r]) and y = max(A[p], A[r]) using Yaroslavskiy's
+// scheme.
+//
+// It returns the final indices lp < rp of the pivots: every element of
+// A[p..lp-1] is less than x, every element of A[lp+1..rp-1] is between
+// x and y inclusive, and every element of A[rp+1..r] is greater than y.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func DualPivotPartition[T cmp.Ordered](A []T, p, r int) (lp, rp int) {
+	return DualPivotPartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// DualPivotPartitionFunc is DualPivotPartition with elements ordered by
+// the cmp function.
+func DualPivotPartitionFunc[T any](A []T, p, r int, cmp func(a, b T) int) (lp, rp int) {
+	if cmp(A[p], A[r]) > 0 {
+		A[p], A[r] = A[r], A[p]
+	}
+	x, y := A[p], A[r]
+
+	// A[p+1..l-1] < x, x <= A[l..k-1] <= y, A[k..g] unknown, A[g+1..r-1] > y.
+	l, k, g := p+1, p+1, r-1
+	for k <= g {
+		if cmp(A[k], x) < 0 {
+			A[k], A[l] = A[l], A[k]
+			l++
+		} else if cmp(A[k], y) > 0 {
+			// Skip the elements at the right end that are already > y.
+			for cmp(A[g], y) > 0 && k < g {
+				g--
+			}
+			A[k], A[g] = A[g], A[k]
+			g--
+			if cmp(A[k], x) < 0 {
+				A[k], A[l] = A[l], A[k]
+				l++
+			}
+		}
+		k++
+	}
+
+	// Move the pivots next to their parts.
+	l--
+	g++
+	A[p], A[l] = A[l], A[p]
+	A[r], A[g] = A[g], A[r]
+	return l, g
+}
+
+// RandomizedDualPivotPartition swaps two distinct random elements of
+// A[p..r] into A[p] and A[r], then partitions A[p..r] around them with
+// DualPivotPartition.
+func RandomizedDualPivotPartition[T cmp.Ordered](A []T, p, r int) (lp, rp int) {
+	return RandomizedDualPivotPartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// RandomizedDualPivotPartitionFunc is RandomizedDualPivotPartition with
+// elements ordered by the cmp function.
+func RandomizedDualPivotPartitionFunc[T any](A []T, p, r int, cmp func(a, b T) int) (lp, rp int) {
+	return randomizedDualPivotPartition(A, p, r, newPivotSource(QuicksortOptions{}), cmp)
+}
+
+// randomizedDualPivotPartition is RandomizedDualPivotPartitionFunc with
+// the pivots chosen by pivots.
+func randomizedDualPivotPartition[T any](A []T, p, r int, pivots *pivotSource, cmp func(a, b T) int) (lp, rp int) {
+	// Pick i uniformly from A[p..r] and j from the rest of it.
+	i := pivots.choose(p, r)
+	A[p], A[i] = A[i], A[p]
+	j := pivots.choose(p+1, r)
+	A[r], A[j] = A[j], A[r]
+
+	return DualPivotPartitionFunc(A, p, r, cmp)
+}
@@ -0,0 +1,122 @@
+package sorting
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestDualPivotPartition(t *testing.T) {
+	partitions := map[string]func(A []int, p, r int) (int, int){
+		"DualPivotPartition":           DualPivotPartition[int],
+		"RandomizedDualPivotPartition": RandomizedDualPivotPartition[int],
+	}
+	for name, partition := range partitions {
+		for _, in := range append(slices.Clone(sortInputs), randomInts(100), []int{2, 1}) {
+			if len(in) < 2 {
+				continue
+			}
+			A := slices.Clone(in)
+			lp, rp := partition(A, 0, len(A)-1)
+			if lp >= rp {
+				t.Fatalf("%s(%v) = %d, %d, want lp < rp", name, in, lp, rp)
+			}
+			x, y := A[lp], A[rp]
+			if x > y {
+				t.Errorf("%s(%v): pivots %d > %d", name, in, x, y)
+			}
+			for k, v := range A {
+				if (k < lp && v >= x) || (k > lp && k < rp && (v < x || v > y)) || (k > rp && v <= y) {
+					t.Errorf("%s(%v) = %d, %d: A[%d] = %d is in the wrong part of %v", name, in, lp, rp, k, v, A)
+					break
+				}
+			}
+
+			sorted, got := slices.Clone(in), slices.Clone(A)
+			slices.Sort(sorted)
+			slices.Sort(got)
+			if !slices.Equal(got, sorted) {
+				t.Errorf("%s(%v) = %v is not a permutation of the input", name, in, A)
+			}
+		}
+	}
+}
+
+func TestDualPivotQuicksortEqualKeys(t *testing.T) {
+	const n = 1 << 12
+	limit := 2 * n * bits.Len(n)
+	for name, in := range map[string][]int{
+		"all-equal":  genInts(n, func(int) int { return 7 }),
+		"few-unique": genInts(n, func(i int) int { return i * 7 % 3 }),
+	} {
+		A := slices.Clone(in)
+		comparisons := 0
+		RandomizedDualPivotQuicksortFunc(A, 0, n-1, func(a, b int) int {
+			comparisons++
+			return cmpInt(a, b)
+		})
+		if !slices.IsSorted(A) {
+			t.Fatalf("RandomizedDualPivotQuicksortFunc did not sort %s input", name)
+		}
+		if comparisons > limit {
+			t.Errorf("RandomizedDualPivotQuicksortFunc made %d comparisons on %s input, want at most %d", comparisons, name, limit)
+		}
+	}
+}
+
+func TestRandomizedDualPivotQuicksortWith(t *testing.T) {
+	// Records with many equal keys, so that an unstable sort's output
+	// depends on the pivots and a replay can be told from a new run.
+	in := make([]record, 300)
+	for i := range in {
+		in[i] = record{key: i * 7 % 5, id: i}
+	}
+
+	var first, second, replayed []int
+	want := slices.Clone(in)
+	RandomizedDualPivotQuicksortWithFunc(want, 0, len(want)-1, QuicksortOptions{Seeded: true, RecordPivots: &first}, byKey)
+	if !slices.IsSortedFunc(want, byKey) {
+		t.Fatalf("RandomizedDualPivotQuicksortWithFunc did not sort: %v", want)
+	}
+
+	got := slices.Clone(in)
+	RandomizedDualPivotQuicksortWithFunc(got, 0, len(got)-1, QuicksortOptions{Seeded: true, RecordPivots: &second}, byKey)
+	if len(first) == 0 || !slices.Equal(first, second) || !slices.Equal(got, want) {
+		t.Errorf("runs with seed 0 chose pivots %v and %v", first, second)
+	}
+
+	got = slices.Clone(in)
+	opts := QuicksortOptions{ReplayPivots: first, RecordPivots: &replayed}
+	RandomizedDualPivotQuicksortWithFunc(got, 0, len(got)-1, opts, byKey)
+	if !slices.Equal(got, want) || !slices.Equal(replayed, first) {
+		t.Errorf("replayed run differs from the recorded one")
+	}
+}
+
+// The benchmarks compare dual-pivot quicksort with Quicksort and
+// RandomizedQuicksort on the same random input.
+
+func benchmarkQuicksortVariant(b *testing.B, sort func(A []int, p, r int)) {
+	in := randomInts(benchSize)
+	A := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		sort(A, 0, len(A)-1)
+	}
+}
+
+func BenchmarkQuicksort(b *testing.B) {
+	benchmarkQuicksortVariant(b, Quicksort[int])
+}
+
+func BenchmarkRandomizedQuicksort(b *testing.B) {
+	benchmarkQuicksortVariant(b, RandomizedQuicksort[int])
+}
+
+func BenchmarkDualPivotQuicksort(b *testing.B) {
+	benchmarkQuicksortVariant(b, DualPivotQuicksort[int])
+}
+
+func BenchmarkRandomizedDualPivotQuicksort(b *testing.B) {
+	benchmarkQuicksortVariant(b, RandomizedDualPivotQuicksort[int])
+}
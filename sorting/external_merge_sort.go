@@ -0,0 +1,341 @@
+package sorting
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/heap"
+)
+
+// RecordFormat selects how ExternalSort encodes integers.
+type RecordFormat int
+
+const (
+	// TextRecords are decimal integers, one per line.
+	TextRecords RecordFormat = iota
+	// BinaryRecords are fixed-width 8-byte little-endian int64 values.
+	BinaryRecords
+)
+
+// ExternalSortOptions configures ExternalSort.
+type ExternalSortOptions struct {
+	// Format is the encoding of both the input and the output.
+	Format RecordFormat
+
+	// MemoryBudget is the number of bytes of records held in memory
+	// while sorting a run, including MergeSortBuffered's scratch buffer.
+	// While merging, it is shared equally by the read buffers of the runs
+	// and the write buffer of the output. Zero or less selects 64 MiB.
+	MemoryBudget int
+
+	// FanIn is the maximum number of runs merged at once. If there are
+	// more runs, they are merged in several passes. Values below 2
+	// select 16.
+	FanIn int
+
+	// TempDir is where sorted runs are spilled. Empty means os.TempDir().
+	TempDir string
+}
+
+// ExternalSort reads integers from r, sorts them in ascending order and
+// writes them to w, using bounded memory however large the input is.
+//
+// The input is read in chunks that fit in the memory budget. Each chunk
+// is sorted with MergeSortBuffered and spilled to a temporary file as a
+// sorted run. The runs are then combined with a k-way merge driven by a
+// min-heap of run heads, at most FanIn runs at a time. If the whole input
+// fits in one chunk, it is sorted in memory and nothing is spilled.
+//
+// All temporary files are removed before ExternalSort returns, whether
+// it succeeds or not.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(MemoryBudget) memory, O(n) disk
+func ExternalSort(r io.Reader, w io.Writer, opts ExternalSortOptions) (err error) {
+	if opts.MemoryBudget <= 0 {
+		opts.MemoryBudget = 64 << 20
+	}
+	if opts.FanIn < 2 {
+		opts.FanIn = 16
+	}
+	if opts.Format != TextRecords && opts.Format != BinaryRecords {
+		return fmt.Errorf("unknown record format %d", opts.Format)
+	}
+
+	var dir string
+	defer func() {
+		if dir != "" {
+			if rmErr := os.RemoveAll(dir); err == nil {
+				err = rmErr
+			}
+		}
+	}()
+
+	runs, err := makeRuns(bufio.NewReader(r), w, opts, &dir)
+	if err != nil || len(runs) == 0 {
+		return err
+	}
+
+	// makeRuns has returned, so its chunk and scratch buffer are garbage
+	// and the merge buffers take their place in the budget.
+	bufSize := mergeBufferSize(opts.MemoryBudget, opts.FanIn)
+
+	// Merge passes until at most FanIn runs remain.
+	for pass := 0; len(runs) > opts.FanIn; pass++ {
+		var next []string
+		for i := 0; i < len(runs); i += opts.FanIn {
+			group := runs[i:min(i+opts.FanIn, len(runs))]
+			name := filepath.Join(dir, fmt.Sprintf("pass%d-run%d", pass, len(next)))
+			if err := mergeRunsToFile(group, name, bufSize); err != nil {
+				return err
+			}
+			for _, run := range group {
+				os.Remove(run)
+			}
+			next = append(next, name)
+		}
+		runs = next
+	}
+
+	out := bufio.NewWriterSize(w, bufSize)
+	if err := mergeRuns(runs, out, opts.Format, bufSize); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// makeRuns reads in chunk by chunk, sorts each chunk with
+// MergeSortBuffered and spills it to a run file in *dir, creating the
+// directory on first use, and returns the names of the runs. If the whole
+// input fits in one chunk, it is written sorted to w instead and no runs
+// are returned.
+func makeRuns(in *bufio.Reader, w io.Writer, opts ExternalSortOptions, dir *string) ([]string, error) {
+	// Each record needs 8 bytes in the chunk and 8 in the scratch buffer.
+	chunkSize := max(opts.MemoryBudget/16, 1)
+	chunk := make([]int64, 0, chunkSize)
+	buf := make([]int64, chunkSize)
+
+	var runs []string
+	for {
+		var err error
+		chunk, err = readChunk(in, opts.Format, chunk[:0])
+		if err != nil {
+			return nil, err
+		}
+		if len(chunk) == 0 {
+			return runs, nil
+		}
+		MergeSortBuffered(chunk, buf, MergeSortOptions{Cutoff: 16})
+
+		if len(runs) == 0 && len(chunk) < cap(chunk) {
+			// Everything fit in memory.
+			return nil, writeRecords(w, opts.Format, chunk)
+		}
+
+		if *dir == "" {
+			if *dir, err = os.MkdirTemp(opts.TempDir, "external-sort-"); err != nil {
+				return nil, err
+			}
+		}
+		run, err := spillRun(*dir, len(runs), chunk)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+}
+
+// minMergeBuffer is the smallest buffer bufio accepts.
+const minMergeBuffer = 16
+
+// mergeBufferSize returns the size of each of the fanIn+1 buffers of a
+// merge, fanIn runs read and one output written, for them to fit in
+// budget together. Budgets too small to give each buffer minMergeBuffer
+// bytes get that many anyway.
+func mergeBufferSize(budget, fanIn int) int {
+	return max(budget/(fanIn+1), minMergeBuffer)
+}
+
+// readChunk appends records from r to chunk until chunk is full or the
+// input is exhausted.
+func readChunk(r *bufio.Reader, format RecordFormat, chunk []int64) ([]int64, error) {
+	for len(chunk) < cap(chunk) {
+		x, err := readRecord(r, format)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunk = append(chunk, x)
+	}
+	return chunk, nil
+}
+
+// readRecord reads one record from r. It returns io.EOF only when the
+// input ends cleanly between records. Blank text lines are skipped.
+func readRecord(r *bufio.Reader, format RecordFormat) (int64, error) {
+	if format == BinaryRecords {
+		var b [8]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return 0, errors.New("truncated binary record")
+			}
+			return 0, err
+		}
+		return int64(binary.LittleEndian.Uint64(b[:])), nil
+	}
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		if s := strings.TrimSpace(line); s != "" {
+			return strconv.ParseInt(s, 10, 64)
+		}
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+	}
+}
+
+// writeRecord writes one record to w in the given format.
+func writeRecord(w *bufio.Writer, format RecordFormat, x int64) error {
+	if format == BinaryRecords {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(x))
+		_, err := w.Write(b[:])
+		return err
+	}
+
+	var b [24]byte
+	line := append(strconv.AppendInt(b[:0], x, 10), '\n')
+	_, err := w.Write(line)
+	return err
+}
+
+// writeRecords writes all of A to w in the given format.
+func writeRecords(w io.Writer, format RecordFormat, A []int64) error {
+	out := bufio.NewWriter(w)
+	for _, x := range A {
+		if err := writeRecord(out, format, x); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+// spillRun writes a sorted chunk to a new run file in dir and returns
+// its name. Runs are always stored as binary records.
+func spillRun(dir string, index int, chunk []int64) (string, error) {
+	f, err := os.Create(filepath.Join(dir, fmt.Sprintf("run%d", index)))
+	if err != nil {
+		return "", err
+	}
+	if err := writeRecords(f, BinaryRecords, chunk); err != nil {
+		f.Close()
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
+
+// mergeRunsToFile merges the given runs into a new binary run file.
+func mergeRunsToFile(runs []string, name string, bufSize int) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriterSize(f, bufSize)
+	if err := mergeRuns(runs, out, BinaryRecords, bufSize); err != nil {
+		f.Close()
+		return err
+	}
+	if err := out.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// mergeRuns performs a k-way merge of the given sorted run files into out.
+//
+// The cursors of the runs sit in a heap.Heap ordered by the runs' heads;
+// the smallest head is written and its run advanced, then the root is
+// fixed in place, or popped once its run is exhausted.
+//
+// Time complexity: O(n log k) for n records in k runs
+func mergeRuns(runs []string, out *bufio.Writer, format RecordFormat, bufSize int) error {
+	cursors := make([]*runCursor, 0, len(runs))
+	defer func() {
+		for _, c := range cursors {
+			c.file.Close()
+		}
+	}()
+
+	live := make([]*runCursor, 0, len(runs))
+	for _, name := range runs {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		c := &runCursor{file: f, r: bufio.NewReaderSize(f, bufSize)}
+		cursors = append(cursors, c)
+		ok, err := c.advance()
+		if err != nil {
+			return err
+		}
+		if ok {
+			live = append(live, c)
+		}
+	}
+	h := heap.FromSlice(live, func(a, b *runCursor) bool {
+		return a.head < b.head
+	})
+
+	for h.Len() > 0 {
+		c, _ := h.Peek()
+		if err := writeRecord(out, format, c.head); err != nil {
+			return err
+		}
+
+		ok, err := c.advance()
+		if err != nil {
+			return err
+		}
+		if ok {
+			h.Fix(0)
+		} else {
+			h.Pop()
+		}
+	}
+	return nil
+}
+
+// runCursor is the read position in one sorted run file.
+type runCursor struct {
+	file *os.File
+	r    *bufio.Reader
+	head int64 // smallest record of the run not yet merged
+}
+
+// advance loads the next record of the run into head. It reports false
+// once the run is exhausted.
+func (c *runCursor) advance() (bool, error) {
+	x, err := readRecord(c.r, BinaryRecords)
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	c.head = x
+	return true, nil
+}
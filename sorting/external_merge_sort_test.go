@@ -0,0 +1,106 @@
+package sorting
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExternalSortText(t *testing.T) {
+	in := randomInts(5000)
+	var text strings.Builder
+	for _, x := range in {
+		text.WriteString(strconv.Itoa(x - 2500))
+		text.WriteString("\n")
+	}
+
+	for _, opts := range []ExternalSortOptions{
+		{},                                 // fits in memory
+		{MemoryBudget: 16 * 100, FanIn: 4}, // 50 runs, several merge passes
+		{MemoryBudget: 16 * 999, FanIn: 16},
+	} {
+		dir := t.TempDir()
+		opts.TempDir = dir
+
+		var out bytes.Buffer
+		if err := ExternalSort(strings.NewReader(text.String()), &out, opts); err != nil {
+			t.Fatalf("ExternalSort(%+v): %v", opts, err)
+		}
+
+		var got []int
+		for _, line := range strings.Fields(out.String()) {
+			x, _ := strconv.Atoi(line)
+			got = append(got, x+2500)
+		}
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("ExternalSort(%+v) output is not the sorted input", opts)
+		}
+		assertEmptyDir(t, dir)
+	}
+}
+
+func TestExternalSortBinary(t *testing.T) {
+	in := []int64{42, -1, 1 << 40, 0, -1 << 50, 7, 7, 3}
+	var raw bytes.Buffer
+	binary.Write(&raw, binary.LittleEndian, in)
+
+	var out bytes.Buffer
+	opts := ExternalSortOptions{Format: BinaryRecords, MemoryBudget: 16 * 3, FanIn: 2, TempDir: t.TempDir()}
+	if err := ExternalSort(&raw, &out, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]int64, len(in))
+	binary.Read(&out, binary.LittleEndian, got)
+	want := slices.Clone(in)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("ExternalSort binary = %v, want %v", got, want)
+	}
+	assertEmptyDir(t, opts.TempDir)
+}
+
+func TestExternalSortCleansUpOnError(t *testing.T) {
+	dir := t.TempDir()
+	in := strings.Repeat("3\n1\n2\n", 100) + "not a number\n"
+
+	err := ExternalSort(strings.NewReader(in), &bytes.Buffer{}, ExternalSortOptions{MemoryBudget: 16 * 10, TempDir: dir})
+	if err == nil {
+		t.Fatal("ExternalSort accepted malformed input")
+	}
+	assertEmptyDir(t, dir)
+}
+
+func TestMergeBufferSize(t *testing.T) {
+	for _, budget := range []int{16 * 3, 1600, 16 * 999, 1 << 20, 64 << 20} {
+		for _, fanIn := range []int{2, 4, 16, 100} {
+			size := mergeBufferSize(budget, fanIn)
+			if budget >= minMergeBuffer*(fanIn+1) && size*(fanIn+1) > budget {
+				t.Errorf("mergeBufferSize(%d, %d) = %d: %d buffers take %d bytes", budget, fanIn, size, fanIn+1, size*(fanIn+1))
+			}
+			if size < minMergeBuffer {
+				t.Errorf("mergeBufferSize(%d, %d) = %d, below bufio's minimum", budget, fanIn, size)
+			}
+			if budget-size*(fanIn+1) > fanIn && size > minMergeBuffer {
+				t.Errorf("mergeBufferSize(%d, %d) = %d leaves %d bytes of the budget unused", budget, fanIn, size, budget-size*(fanIn+1))
+			}
+		}
+	}
+}
+
+func assertEmptyDir(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("temporary files left behind in %s: %v", dir, entries)
+	}
+}
@@ -0,0 +1,125 @@
+package sorting
+
+import "cmp"
+
+// FuzzySort fuzzy-sorts the closed intervals A[p..r], as in CLRS Problem
+// 7-6: it permutes them so that there are points c_i in the intervals,
+// c_i in [A[i].Start, A[i].End], with c_p <= c_{p+1} <= ... <= c_r.
+//
+// It is randomized quicksort on intervals. A random pivot interval is
+// narrowed, in one pass, to its intersection with every interval that
+// still overlaps it, and a point c of that intersection is chosen. The
+// intervals are then partitioned into those that end before c, those
+// that contain c, and those that start after c. The middle part contains
+// c and needs no further sorting, so the more the intervals overlap, the
+// less is left to sort: when they all share a point, the first pass
+// finishes the sort.
+//
+// Time complexity (expected): O(n log n) in general, O(n) when all the
+// intervals overlap
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack, as it recurses only on
+// the smaller side
+func FuzzySort[T cmp.Ordered](A []Interval[T], p, r int) {
+	FuzzySortFunc(A, p, r, cmp.Compare[T])
+}
+
+// FuzzySortFunc is FuzzySort with interval endpoints ordered by the cmp
+// function.
+func FuzzySortFunc[T any](A []Interval[T], p, r int, cmp func(a, b T) int) {
+	fuzzySort(A, p, r, newPivotSource(QuicksortOptions{}), cmp)
+}
+
+// FuzzySortWith fuzzy-sorts the closed intervals A[p..r] as FuzzySort
+// does, with the source of random pivot intervals selected by opts.
+// opts.Scheme is ignored.
+//
+// Time complexity (expected): O(n log n) in general, O(n) when all the
+// intervals overlap
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack
+func FuzzySortWith[T cmp.Ordered](A []Interval[T], p, r int, opts QuicksortOptions) {
+	FuzzySortWithFunc(A, p, r, opts, cmp.Compare[T])
+}
+
+// FuzzySortWithFunc is FuzzySortWith with interval endpoints ordered by
+// the cmp function.
+func FuzzySortWithFunc[T any](A []Interval[T], p, r int, opts QuicksortOptions, cmp func(a, b T) int) {
+	fuzzySort(A, p, r, newPivotSource(opts), cmp)
+}
+
+// fuzzySort is FuzzySortFunc with the pivot intervals chosen by pivots.
+func fuzzySort[T any](A []Interval[T], p, r int, pivots *pivotSource, cmp func(a, b T) int) {
+	for p < r {
+		q, t := fuzzyPartition(A, p, r, pivots, cmp)
+
+		// Recurse on the smaller side and loop on the larger one.
+		if q-p < r-t {
+			fuzzySort(A, p, q-1, pivots, cmp)
+			p = t + 1
+		} else {
+			fuzzySort(A, t+1, r, pivots, cmp)
+			r = q - 1
+		}
+	}
+}
+
+// FuzzyPartition partitions the closed intervals A[p..r] around a point
+// c shared by a random pivot interval and as many others as one pass can
+// find.
+//
+// It returns q and t such that every interval of A[p..q-1] ends before
+// c, every interval of A[q..t] contains c, and every interval of
+// A[t+1..r] starts after c. A[q..t] is never empty.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func FuzzyPartition[T cmp.Ordered](A []Interval[T], p, r int) (q, t int) {
+	return FuzzyPartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// FuzzyPartitionFunc is FuzzyPartition with interval endpoints ordered
+// by the cmp function.
+func FuzzyPartitionFunc[T any](A []Interval[T], p, r int, cmp func(a, b T) int) (q, t int) {
+	return fuzzyPartition(A, p, r, newPivotSource(QuicksortOptions{}), cmp)
+}
+
+// fuzzyPartition is FuzzyPartitionFunc with the pivot interval chosen by
+// pivots.
+func fuzzyPartition[T any](A []Interval[T], p, r int, pivots *pivotSource, cmp func(a, b T) int) (q, t int) {
+	// Narrow the pivot to its intersection [a, b] with every interval
+	// that overlaps what is left of it.
+	pivot := A[pivots.choose(p, r)]
+	a, b := pivot.Start, pivot.End
+	for i := p; i <= r; i++ {
+		if cmp(A[i].Start, b) <= 0 && cmp(A[i].End, a) >= 0 {
+			if cmp(A[i].Start, a) > 0 {
+				a = A[i].Start
+			}
+			if cmp(A[i].End, b) < 0 {
+				b = A[i].End
+			}
+		}
+	}
+	c := a
+
+	// Three-way partition, as in ThreeWayPartition, on the position of
+	// each interval relative to c.
+	// A[p..lt-1] end before c, A[lt..i-1] contain c, A[i..gt] unknown,
+	// A[gt+1..r] start after c.
+	lt, i, gt := p, p, r
+	for i <= gt {
+		switch {
+		case cmp(A[i].End, c) < 0:
+			A[lt], A[i] = A[i], A[lt]
+			lt++
+			i++
+		case cmp(A[i].Start, c) > 0:
+			A[i], A[gt] = A[gt], A[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
@@ -0,0 +1,119 @@
+package sorting
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// fuzzySorted reports whether points c_i can be chosen from the closed
+// intervals of A in nondecreasing order, taking each c_i as small as
+// possible.
+func fuzzySorted(A []Interval[int]) error {
+	for i, c := 0, 0; i < len(A); i++ {
+		if i == 0 || A[i].Start > c {
+			c = A[i].Start
+		}
+		if c > A[i].End {
+			return fmt.Errorf("interval %d %v ends before %d", i, A[i], c)
+		}
+	}
+	return nil
+}
+
+// randomIntervals returns n intervals with starts in [0, span) and
+// lengths in [0, maxLen).
+func randomIntervals(rng *rand.Rand, n, span, maxLen int) []Interval[int] {
+	A := make([]Interval[int], n)
+	for i := range A {
+		a := rng.Intn(span)
+		A[i] = Interval[int]{a, a + rng.Intn(maxLen)}
+	}
+	return A
+}
+
+func TestFuzzySort(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+	inputs := [][]Interval[int]{
+		{},
+		{{1, 2}},
+		{{5, 9}, {1, 3}, {2, 6}},
+		{{3, 3}, {3, 3}, {1, 1}},
+		{{0, 10}, {4, 5}, {1, 2}, {8, 9}, {6, 7}},
+	}
+	for _, maxLen := range []int{1, 10, 100, 1000} {
+		inputs = append(inputs, randomIntervals(rng, 500, 1000, maxLen))
+	}
+
+	for _, in := range inputs {
+		A := slices.Clone(in)
+		FuzzySort(A, 0, len(A)-1)
+		if err := fuzzySorted(A); err != nil {
+			t.Errorf("FuzzySort(%v) = %v: %v", in, A, err)
+		}
+
+		// The output is a permutation of the input.
+		cmpInterval := func(x, y Interval[int]) int {
+			if x.Start != y.Start {
+				return x.Start - y.Start
+			}
+			return x.End - y.End
+		}
+		got, want := slices.Clone(A), slices.Clone(in)
+		slices.SortFunc(got, cmpInterval)
+		slices.SortFunc(want, cmpInterval)
+		if !slices.Equal(got, want) {
+			t.Errorf("FuzzySort(%v) = %v is not a permutation of the input", in, A)
+		}
+	}
+}
+
+func TestFuzzySortOverlap(t *testing.T) {
+	// When every interval contains 5000, one partition finishes the sort.
+	const n = 4096
+	rng := rand.New(rand.NewSource(7))
+	overlapping := make([]Interval[int], n)
+	for i := range overlapping {
+		overlapping[i] = Interval[int]{rng.Intn(5000), 5000 + rng.Intn(5000)}
+	}
+	disjoint := make([]Interval[int], n)
+	for i, k := range rng.Perm(n) {
+		disjoint[i] = Interval[int]{2 * k, 2*k + 1}
+	}
+
+	count := func(A []Interval[int]) int {
+		comparisons := 0
+		FuzzySortFunc(A, 0, len(A)-1, func(a, b int) int {
+			comparisons++
+			return cmpInt(a, b)
+		})
+		if err := fuzzySorted(A); err != nil {
+			t.Fatal(err)
+		}
+		return comparisons
+	}
+
+	o, d := count(overlapping), count(disjoint)
+	if o > 6*n {
+		t.Errorf("FuzzySortFunc made %d comparisons on overlapping intervals, want at most %d", o, 6*n)
+	}
+	if o > d/4 {
+		t.Errorf("FuzzySortFunc made %d comparisons on overlapping intervals and %d on disjoint ones, want far fewer on overlapping", o, d)
+	}
+}
+
+func TestFuzzySortWithSeed(t *testing.T) {
+	in := randomIntervals(rand.New(rand.NewSource(8)), 500, 1000, 10)
+	var first, second []int
+	A := slices.Clone(in)
+	FuzzySortWith(A, 0, len(A)-1, QuicksortOptions{Seeded: true, Seed: 3, RecordPivots: &first})
+	if err := fuzzySorted(A); err != nil {
+		t.Fatalf("FuzzySortWith: %v", err)
+	}
+	B := slices.Clone(in)
+	FuzzySortWith(B, 0, len(B)-1, QuicksortOptions{Seeded: true, Seed: 3, RecordPivots: &second})
+	if len(first) == 0 || !slices.Equal(first, second) || !slices.Equal(A, B) {
+		t.Errorf("runs with the same seed chose pivots %v and %v", first, second)
+	}
+}
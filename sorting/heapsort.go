@@ -0,0 +1,60 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/heap"
+)
+
+// HeapSort sorts the given slice in ascending order
+// using the heapsort algorithm.
+//
+// This algorithm works in two main phases:
+//  1. Build a max-heap from the input array so that the largest element
+//     is at the root (index 0), with heap.FromSlice.
+//  2. Repeatedly Pop the heap, which swaps the root with the last element
+//     of the heap, reduces the heap size by one, and restores the max-heap
+//     property by calling heapify on the root.
+//
+// The sort is not stable: moving the root to the end of the heap can carry
+// an element past others that compare equal to it.
+//
+// Time complexity (worst case): O(n log n)
+// Space complexity: O(1) – in-place sorting
+func HeapSort[T cmp.Ordered](arr []T) {
+	HeapSortFunc(arr, cmp.Compare[T])
+}
+
+// HeapSortFunc sorts the given slice in ascending order as determined
+// by the cmp function, using the heapsort algorithm.
+//
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b, as in slices.SortFunc.
+//
+// The sort is not stable.
+//
+// Time complexity (worst case): O(n log n)
+// Space complexity: O(1) – in-place sorting
+func HeapSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	heapSort(arr, cmp, nil)
+}
+
+// heapSort is HeapSortFunc reporting its work to pr.
+func heapSort[T any](arr []T, cmp func(a, b T) int, pr *probe[T]) {
+	pr.enter(1)
+
+	// Build a max-heap on arr itself
+	less := func(a, b T) bool { return cmp(a, b) > 0 }
+	var h *heap.Heap[T]
+	if pr == nil {
+		h = heap.FromSlice(arr, less)
+	} else {
+		h = heap.FromSliceObserved(arr, less, heapObserver[T]{pr})
+	}
+
+	// Each Pop moves the current maximum just past the end of the heap,
+	// which is its final position
+	for h.Len() > 1 {
+		h.Pop()
+	}
+}
@@ -0,0 +1,15 @@
+package sorting
+
+import "cmp"
+
+// HeapSortWithStats sorts the given slice exactly like HeapSort and
+// reports the work it did.
+//
+// It makes at most 2 comparisons and 1 swap per level of every heapify,
+// i.e. O(n log n) in total. It never allocates, and since heapify is
+// iterative, MaxDepth is always 1.
+func HeapSortWithStats[T cmp.Ordered](arr []T) SortStats {
+	var s SortStats
+	heapSort(arr, countCalls(cmp.Compare[T], &s.Comparisons), &probe[T]{stats: &s})
+	return s
+}
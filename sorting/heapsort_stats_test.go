@@ -0,0 +1,29 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestHeapSortWithStats(t *testing.T) {
+	rng := rand.New(rand.NewSource(10))
+	A := make([]int, 1024)
+	for i := range A {
+		A[i] = rng.Intn(1000)
+	}
+
+	s := HeapSortWithStats(A)
+	if !slices.IsSorted(A) {
+		t.Fatal("HeapSortWithStats did not sort")
+	}
+
+	// 2 comparisons per level of each of the n heapify calls of the
+	// sorting phase, plus the O(n) build: at most 2n lg n + 2n.
+	if limit := 2*1024*10 + 2*1024; s.Comparisons > limit {
+		t.Errorf("HeapSortWithStats made %d comparisons, want at most %d", s.Comparisons, limit)
+	}
+	if s.Swaps < 1023 || s.Moves != 0 || s.Allocations != 0 || s.MaxDepth != 1 {
+		t.Errorf("HeapSortWithStats = %+v", s)
+	}
+}
@@ -0,0 +1,48 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestHeapSort(t *testing.T) {
+	inputs := [][]int{
+		{},
+		{1},
+		{2, 1},
+		{1, 2, 3, 4, 5},
+		{5, 4, 3, 2, 1},
+		{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5},
+		{7, 7, 7, 7},
+		{-3, 10, 0, -7, 2, 2, -3},
+	}
+	for _, in := range inputs {
+		got := slices.Clone(in)
+		HeapSort(got)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("HeapSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+
+	words := []string{"pear", "apple", "fig", "apple"}
+	HeapSort(words)
+	if !slices.IsSorted(words) {
+		t.Errorf("HeapSort on strings = %v, not sorted", words)
+	}
+}
+
+func TestHeapSortFuncNotStable(t *testing.T) {
+	type record struct{ key, id int }
+	byKey := func(a, b record) int { return cmp.Compare(a.key, b.key) }
+
+	// Two equal keys: the root is swapped behind its equal child.
+	R := []record{{1, 0}, {1, 1}}
+	HeapSortFunc(R, byKey)
+	if R[0].id != 1 || R[1].id != 0 {
+		t.Errorf("HeapSortFunc(%v): expected equal keys to be reordered", R)
+	}
+}
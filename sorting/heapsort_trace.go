@@ -0,0 +1,14 @@
+package sorting
+
+import "cmp"
+
+// HeapSortTraced sorts the given slice exactly like HeapSort, reporting
+// every step to sink.
+//
+// The steps are those of the heap.Heap that HeapSort builds on the
+// slice. Each call of heapify is announced by a heapify event for the
+// node it starts from, and every level it descends adds compare and
+// swap events. Moving the maximum to the end of the heap is a swap(0, i).
+func HeapSortTraced[T cmp.Ordered](arr []T, sink TraceSink[T]) {
+	heapSort(arr, cmp.Compare[T], &probe[T]{sink: sink})
+}
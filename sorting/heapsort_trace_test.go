@@ -0,0 +1,31 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHeapSortTracedReplay(t *testing.T) {
+	in := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}
+	A := slices.Clone(in)
+	var rec TraceRecorder[int]
+	HeapSortTraced(A, &rec)
+
+	if !slices.IsSorted(A) {
+		t.Fatalf("HeapSortTraced = %v, not sorted", A)
+	}
+	if got := Replay(in, rec.Events, nil); !slices.Equal(got, A) {
+		t.Errorf("replay ends in %v, want %v", got, A)
+	}
+
+	// One heapify per internal node to build the heap, then one per extraction.
+	heapifies := 0
+	for _, e := range rec.Events {
+		if e.Kind == EventHeapify {
+			heapifies++
+		}
+	}
+	if want := len(in)/2 + len(in) - 1; heapifies != want {
+		t.Errorf("got %d heapify events, want %d", heapifies, want)
+	}
+}
@@ -0,0 +1,60 @@
+package sorting
+
+import "cmp"
+
+// InsertionSort sorts the given slice in ascending order
+// using the insertion sort algorithm.
+//
+// The algorithm works by building a sorted portion of the slice
+// one element at a time. For each element in A[1:], it finds the
+// correct position among the already-sorted elements (to its left)
+// and inserts it there.
+//
+// The sort is stable: an element is only shifted past keys that are
+// strictly greater than it, so equal elements keep their relative order.
+//
+// Time complexity: O(n²)
+// Space complexity: O(1)
+func InsertionSort[T cmp.Ordered](A []T) {
+	InsertionSortFunc(A, cmp.Compare[T])
+}
+
+// InsertionSortFunc sorts the given slice in ascending order as determined
+// by the cmp function, using the insertion sort algorithm.
+//
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b, as in slices.SortFunc.
+//
+// The sort is stable.
+//
+// Time complexity: O(n²)
+// Space complexity: O(1)
+func InsertionSortFunc[T any](A []T, cmp func(a, b T) int) {
+	insertionSort(A, cmp, nil)
+}
+
+// insertionSort is InsertionSortFunc reporting its work to pr.
+func insertionSort[T any](A []T, cmp func(a, b T) int, pr *probe[T]) {
+	pr.enter(1)
+	for i := 1; i < len(A); i++ {
+		key := A[i]
+		j := i - 1
+
+		// Shift elements of A[0..i-1] that are greater than key
+		// one position to the right to make space for insertion.
+		for j >= 0 {
+			// The key's slot is j+1 while it is held outside the array.
+			pr.compare(j, j+1)
+			if cmp(A[j], key) <= 0 {
+				break
+			}
+			A[j+1] = A[j]
+			pr.write(j+1, A[j])
+			j--
+		}
+
+		// Place key in its correct sorted position.
+		A[j+1] = key
+		pr.write(j+1, key)
+	}
+}
@@ -0,0 +1,80 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// record is a key with the position it had in the input, used to check
+// whether a sort keeps equal keys in their original order.
+type record struct {
+	key int
+	id  int
+}
+
+func byKey(a, b record) int { return cmp.Compare(a.key, b.key) }
+
+// sortInputs are shared by the sort tests in this directory.
+var sortInputs = [][]int{
+	{},
+	{1},
+	{2, 1},
+	{1, 2, 3, 4, 5},
+	{5, 4, 3, 2, 1},
+	{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5},
+	{7, 7, 7, 7},
+	{-3, 10, 0, -7, 2, 2, -3},
+}
+
+// records builds one record per key, numbered in input order.
+func records(keys ...int) []record {
+	R := make([]record, len(keys))
+	for i, k := range keys {
+		R[i] = record{key: k, id: i}
+	}
+	return R
+}
+
+// isStable reports whether records with equal keys appear in increasing id order.
+func isStable(R []record) bool {
+	for i := 1; i < len(R); i++ {
+		if R[i-1].key == R[i].key && R[i-1].id > R[i].id {
+			return false
+		}
+	}
+	return true
+}
+
+func TestInsertionSort(t *testing.T) {
+	for _, in := range sortInputs {
+		got := slices.Clone(in)
+		InsertionSort(got)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("InsertionSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+
+	words := []string{"pear", "apple", "fig", "apple"}
+	InsertionSort(words)
+	if !slices.IsSorted(words) {
+		t.Errorf("InsertionSort on strings = %v, not sorted", words)
+	}
+}
+
+func TestInsertionSortFunc(t *testing.T) {
+	A := []int{3, 1, 2}
+	InsertionSortFunc(A, func(a, b int) int { return cmp.Compare(b, a) })
+	if !slices.Equal(A, []int{3, 2, 1}) {
+		t.Errorf("descending InsertionSortFunc = %v, want [3 2 1]", A)
+	}
+
+	R := records(2, 1, 2, 0, 1, 2)
+	InsertionSortFunc(R, byKey)
+	if !slices.IsSortedFunc(R, byKey) || !isStable(R) {
+		t.Errorf("InsertionSortFunc is not stable: %v", R)
+	}
+}
@@ -0,0 +1,17 @@
+package sorting
+
+// Interval is an interval of the line from Start to End, Start <= End.
+//
+// Whether the endpoints belong to the interval is up to the algorithm
+// using it: FuzzySort treats intervals as closed, [Start, End], and the
+// activity selector greedy.SelectActivities treats activities as
+// half-open, [Start, End).
+//
+// It is defined next to FuzzySort, the sort it exists for. The packages
+// of this module are layered: sorting builds only on the data structures
+// of heap and lists, and the algorithms of later chapters, greedy among
+// them, build on sorting, never the other way round. A type that two of
+// them share therefore lives in the lower one.
+type Interval[T any] struct {
+	Start, End T
+}
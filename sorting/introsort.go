@@ -0,0 +1,77 @@
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// introsortCutoff is the subarray length at or below which Introsort
+// switches to insertion sort.
+const introsortCutoff = 16
+
+// Introsort sorts the given slice in ascending order using David
+// Musser's introspective sort.
+//
+// It is quicksort with three safeguards taken from the rest of this
+// package:
+//  1. The pivot is the median of the first, middle and last elements,
+//     moved to the end of the subarray for Partition, so sorted and
+//     reversed input split evenly.
+//  2. Once the recursion is deeper than 2⌊lg n⌋, the subarray is
+//     finished with HeapSort, so input that still defeats the pivot
+//     choice costs O(n log n) and not O(n²).
+//  3. Subarrays of at most 16 elements are finished with InsertionSort,
+//     which is faster than quicksort on so few elements.
+//
+// The sort is not stable.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(log n) recursion stack
+func Introsort[T cmp.Ordered](A []T) {
+	IntrosortFunc(A, cmp.Compare[T])
+}
+
+// IntrosortFunc is Introsort with elements ordered by the cmp function.
+func IntrosortFunc[T any](A []T, cmp func(a, b T) int) {
+	if len(A) < 2 {
+		return
+	}
+	introsort(A, 0, len(A)-1, 2*(bits.Len(uint(len(A)))-1), cmp)
+}
+
+// introsort sorts A[p..r], falling back to heapsort once depthLimit
+// more levels of quicksort have been used.
+func introsort[T any](A []T, p, r, depthLimit int, cmp func(a, b T) int) {
+	for r-p+1 > introsortCutoff {
+		if depthLimit == 0 {
+			HeapSortFunc(A[p:r+1], cmp)
+			return
+		}
+		depthLimit--
+
+		medianOfThree(A, p, p+(r-p)/2, r, cmp)
+		q := PartitionFunc(A, p, r, cmp)
+
+		// Recurse on the left side and loop on the right one.
+		introsort(A, p, q-1, depthLimit, cmp)
+		p = q + 1
+	}
+	InsertionSortFunc(A[p:r+1], cmp)
+}
+
+// medianOfThree moves the median of A[i], A[j] and A[k] to A[k], where
+// Partition takes its pivot from, and the largest of them to A[j].
+func medianOfThree[T any](A []T, i, j, k int, cmp func(a, b T) int) {
+	// Sort the three so that A[i] <= A[j] <= A[k] ...
+	if cmp(A[j], A[i]) < 0 {
+		A[i], A[j] = A[j], A[i]
+	}
+	if cmp(A[k], A[j]) < 0 {
+		A[j], A[k] = A[k], A[j]
+		if cmp(A[j], A[i]) < 0 {
+			A[i], A[j] = A[j], A[i]
+		}
+	}
+	// ... then exchange the median and the largest.
+	A[j], A[k] = A[k], A[j]
+}
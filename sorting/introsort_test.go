@@ -0,0 +1,69 @@
+package sorting
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestIntrosort(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(1000)) {
+		got := slices.Clone(in)
+		Introsort(got)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("Introsort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestIntrosortWorstCase(t *testing.T) {
+	// All-equal keys make every Lomuto partition maximally unbalanced,
+	// and the remaining inputs are bad for naive pivot choices. The
+	// heapsort fallback must keep all of them within O(n log n).
+	const n = 1 << 14
+	lg := bits.Len(n)
+	inputs := map[string][]int{
+		"all-equal":  genInts(n, func(int) int { return 7 }),
+		"sorted":     genInts(n, func(i int) int { return i }),
+		"reversed":   genInts(n, func(i int) int { return n - i }),
+		"organ-pipe": genInts(n, func(i int) int { return min(i, n-1-i) }),
+		"few-unique": genInts(n, func(i int) int { return i % 3 }),
+	}
+	for name, A := range inputs {
+		comparisons := 0
+		IntrosortFunc(A, func(a, b int) int {
+			comparisons++
+			return cmpInt(a, b)
+		})
+		if !slices.IsSorted(A) {
+			t.Fatalf("IntrosortFunc did not sort %s input", name)
+		}
+		if limit := 4 * n * lg; comparisons > limit {
+			t.Errorf("IntrosortFunc made %d comparisons on %s input, want at most %d", comparisons, name, limit)
+		}
+	}
+}
+
+func TestMedianOfThree(t *testing.T) {
+	for _, in := range [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}, {2, 2, 1}, {1, 1, 1}} {
+		A := slices.Clone(in)
+		medianOfThree(A, 0, 1, 2, cmpInt)
+		sorted := slices.Clone(in)
+		slices.Sort(sorted)
+		if A[2] != sorted[1] || A[1] != sorted[2] {
+			t.Errorf("medianOfThree(%v) = %v, want the median %d last and the maximum %d in the middle", in, A, sorted[1], sorted[2])
+		}
+	}
+}
+
+func BenchmarkIntrosort(b *testing.B) {
+	in := randomInts(benchSize)
+	A := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		Introsort(A)
+	}
+}
@@ -0,0 +1,162 @@
+package sorting
+
+import (
+	"cmp"
+	"errors"
+)
+
+// MergeSortCountInversions returns a sorted copy of the given slice
+// together with the number of inversions in it (CLRS Problem 2-4).
+//
+// An inversion is a pair of indices i < j with A[i] > A[j]. Every time
+// Merge takes an element from the right half, that element forms an
+// inversion with each element still waiting in the left half, so the
+// count comes for free while merge sorting: this runs MergeSort's own
+// code with the count reported through its probe.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n)
+func MergeSortCountInversions[T cmp.Ordered](A []T) ([]T, int) {
+	return MergeSortCountInversionsFunc(A, cmp.Compare[T])
+}
+
+// MergeSortCountInversionsFunc is MergeSortCountInversions with elements
+// ordered by the cmp function.
+func MergeSortCountInversionsFunc[T any](A []T, cmp func(a, b T) int) ([]T, int) {
+	var inversions int
+	sorted := mergeSort(A, 0, 1, cmp, &probe[T]{inversions: &inversions})
+	return sorted, inversions
+}
+
+// MergeCountInversions combines two sorted slices into a single sorted
+// slice, like Merge, and also returns the number of pairs (x, y) with x
+// in Left, y in Right and x > y.
+//
+// Time complexity: O(n) where n = len(Left) + len(Right)
+// Space complexity: O(n)
+func MergeCountInversions[T cmp.Ordered](Left, Right []T) ([]T, int) {
+	return MergeCountInversionsFunc(Left, Right, cmp.Compare[T])
+}
+
+// MergeCountInversionsFunc is MergeCountInversions with elements ordered
+// by the cmp function.
+func MergeCountInversionsFunc[T any](Left, Right []T, cmp func(a, b T) int) ([]T, int) {
+	var inversions int
+	merged := merge(Left, Right, 0, cmp, &probe[T]{inversions: &inversions})
+	return merged, inversions
+}
+
+// KendallTauDistance returns the number of pairs of elements that the two
+// rankings a and b put in opposite order.
+//
+// a and b must be permutations of the same distinct elements; otherwise an
+// error is returned. The distance is the inversion count of a once every
+// element is replaced by its position in b.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n)
+func KendallTauDistance[T comparable](a, b []T) (int, error) {
+	if len(a) != len(b) {
+		return 0, errors.New("rankings have different lengths")
+	}
+
+	position := make(map[T]int, len(b))
+	for i, x := range b {
+		if _, dup := position[x]; dup {
+			return 0, errors.New("ranking contains duplicate elements")
+		}
+		position[x] = i
+	}
+
+	ranks := make([]int, len(a))
+	seen := make(map[T]bool, len(a))
+	for i, x := range a {
+		p, ok := position[x]
+		if !ok || seen[x] {
+			return 0, errors.New("rankings are not permutations of the same elements")
+		}
+		seen[x] = true
+		ranks[i] = p
+	}
+
+	_, inversions := MergeSortCountInversions(ranks)
+	return inversions, nil
+}
+
+// Presortedness reports how far a slice is from being sorted in
+// ascending order.
+type Presortedness struct {
+	N int // number of elements
+
+	// Inversions is the number of pairs i < j with A[i] > A[j]: the
+	// Kendall tau distance between A and its sorted order, and the
+	// number of swaps InsertionSort performs.
+	Inversions int
+
+	// KendallTau is Inversions normalized to [0, 1] by the n(n-1)/2
+	// possible pairs: 0 for sorted input, 1 for strictly decreasing input.
+	KendallTau float64
+
+	// Runs is the number of maximal ascending (nondecreasing) runs.
+	Runs int
+
+	// LongestSorted is the length of the longest nondecreasing
+	// subsequence; N - LongestSorted elements have to be moved to
+	// sort A.
+	LongestSorted int
+}
+
+// MeasurePresortedness computes the Presortedness of A without
+// modifying it.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n)
+func MeasurePresortedness[T cmp.Ordered](A []T) Presortedness {
+	return MeasurePresortednessFunc(A, cmp.Compare[T])
+}
+
+// MeasurePresortednessFunc is MeasurePresortedness with elements ordered
+// by the cmp function.
+func MeasurePresortednessFunc[T any](A []T, cmp func(a, b T) int) Presortedness {
+	n := len(A)
+	m := Presortedness{N: n}
+	if n == 0 {
+		return m
+	}
+
+	_, m.Inversions = MergeSortCountInversionsFunc(A, cmp)
+	if n > 1 {
+		m.KendallTau = float64(m.Inversions) / (float64(n) * float64(n-1) / 2)
+	}
+
+	m.Runs = 1
+	for i := 1; i < n; i++ {
+		if cmp(A[i-1], A[i]) > 0 {
+			m.Runs++
+		}
+	}
+
+	m.LongestSorted = longestNondecreasing(A, cmp)
+	return m
+}
+
+// longestNondecreasing returns the length of the longest nondecreasing
+// subsequence of A.
+//
+// tails[k] holds the smallest possible last element of a nondecreasing
+// subsequence of length k+1; tails stays sorted, so each element is
+// placed with a binary search.
+//
+// Time complexity: O(n log n)
+func longestNondecreasing[T any](A []T, cmp func(a, b T) int) int {
+	tails := make([]T, 0, len(A))
+	for _, x := range A {
+		k := upperBound(tails, x, cmp)
+		if k == len(tails) {
+			tails = append(tails, x)
+		} else {
+			tails[k] = x
+		}
+	}
+	return len(tails)
+}
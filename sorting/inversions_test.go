@@ -0,0 +1,71 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+// bruteInversions counts inversions by checking every pair.
+func bruteInversions(A []int) int {
+	count := 0
+	for i := range A {
+		for j := i + 1; j < len(A); j++ {
+			if A[i] > A[j] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func TestMergeSortCountInversions(t *testing.T) {
+	inputs := append(slices.Clone(sortInputs), []int{2, 3, 8, 6, 1}, randomInts(200))
+	for _, in := range inputs {
+		sorted, got := MergeSortCountInversions(slices.Clone(in))
+		if want := bruteInversions(in); got != want {
+			t.Errorf("MergeSortCountInversions(%v) counted %d, want %d", in, got, want)
+		}
+		if !slices.IsSorted(sorted) {
+			t.Errorf("MergeSortCountInversions(%v) returned unsorted %v", in, sorted)
+		}
+	}
+}
+
+func TestKendallTauDistance(t *testing.T) {
+	d, err := KendallTauDistance([]string{"a", "b", "c", "d"}, []string{"b", "a", "d", "c"})
+	if err != nil || d != 2 {
+		t.Errorf("KendallTauDistance = %d, %v; want 2, nil", d, err)
+	}
+
+	d, err = KendallTauDistance([]int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1})
+	if err != nil || d != 10 {
+		t.Errorf("KendallTauDistance of reversed ranking = %d, %v; want 10, nil", d, err)
+	}
+
+	for _, bad := range [][2][]int{
+		{{1, 2, 3}, {1, 2}},
+		{{1, 2, 3}, {1, 2, 4}},
+		{{1, 1, 2}, {1, 2, 1}},
+	} {
+		if _, err := KendallTauDistance(bad[0], bad[1]); err == nil {
+			t.Errorf("KendallTauDistance(%v, %v) returned no error", bad[0], bad[1])
+		}
+	}
+}
+
+func TestMeasurePresortedness(t *testing.T) {
+	tests := []struct {
+		in   []int
+		want Presortedness
+	}{
+		{nil, Presortedness{}},
+		{[]int{1, 2, 3, 4}, Presortedness{N: 4, Runs: 1, LongestSorted: 4}},
+		{[]int{4, 3, 2, 1}, Presortedness{N: 4, Inversions: 6, KendallTau: 1, Runs: 4, LongestSorted: 1}},
+		{[]int{1, 3, 2, 2, 5, 4}, Presortedness{N: 6, Inversions: 3, KendallTau: 0.2, Runs: 3, LongestSorted: 4}},
+	}
+	for _, tt := range tests {
+		if got := MeasurePresortedness(tt.in); got != tt.want {
+			t.Errorf("MeasurePresortedness(%v) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package sorting
+
+// Antiqsort runs sort on the n items 0..n-1 with M. D. McIlroy's
+// adversary comparator ("A Killer Adversary for Quicksort", 1999) and
+// returns the input it built, together with the number of comparisons
+// sort made.
+//
+// The adversary decides the values of the items lazily. All items start
+// as "gas", which compares greater than any value decided so far. When
+// two gas items are compared, one of them is frozen to the next smallest
+// value; the adversary freezes the item it takes to be the pivot, the
+// gas item seen in the previous comparison, so that the pivot ends up
+// nearly minimal and the partition maximally unbalanced. Items never
+// frozen all get the value n.
+//
+// sort must sort A with cmp and be a comparison sort. The returned input
+// forces the same comparisons on any deterministic sort that behaves as
+// sort did: for a quicksort that picks its pivots by position, Θ(n²).
+// A randomized sort is defeated only during the adversarial run itself.
+//
+// Time complexity: the time of sort, plus O(n)
+// Space complexity: O(n)
+func Antiqsort(n int, sort func(A []int, cmp func(a, b int) int)) (input []int, comparisons int) {
+	gas := n
+	val := make([]int, n)
+	for i := range val {
+		val[i] = gas
+	}
+	solid, candidate := 0, 0
+
+	freeze := func(x int) {
+		val[x] = solid
+		solid++
+	}
+	cmp := func(x, y int) int {
+		comparisons++
+		if val[x] == gas && val[y] == gas {
+			if x == candidate {
+				freeze(x)
+			} else {
+				freeze(y)
+			}
+		}
+		if val[x] == gas {
+			candidate = x
+		} else if val[y] == gas {
+			candidate = y
+		}
+		return val[x] - val[y]
+	}
+
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	sort(items, cmp)
+	return val, comparisons
+}
+
+// SortedKiller returns 0, 1, ..., n-1. Already-sorted input makes every
+// Lomuto partition with the last element as pivot maximally unbalanced,
+// so Quicksort makes n(n-1)/2 comparisons on it.
+func SortedKiller(n int) []int {
+	return genInts(n, func(i int) int { return i })
+}
+
+// AllEqualKiller returns n zeros. Partition puts every key equal to the
+// pivot on the low side, so Quicksort and RandomizedQuicksort both make
+// n(n-1)/2 comparisons on it, whatever the pivot.
+func AllEqualKiller(n int) []int {
+	return genInts(n, func(int) int { return 0 })
+}
+
+// MedianOfThreeKiller returns an input on which quicksort with the
+// median-of-three pivots of Introsort and ParallelQuicksort (the median
+// of the first, middle and last elements, partitioned with Partition)
+// takes Θ(n²) time.
+//
+// It is the input built by Antiqsort against that quicksort, so it is
+// the same for every call with the same n. Introsort switches to
+// HeapSort on it after 2⌊lg n⌋ levels.
+func MedianOfThreeKiller(n int) []int {
+	input, _ := Antiqsort(n, func(A []int, cmp func(a, b int) int) {
+		medianOfThreeQuicksort(A, 0, len(A)-1, cmp)
+	})
+	return input
+}
+
+// medianOfThreeQuicksort is quicksort with the pivot rule of Introsort
+// and nothing else.
+func medianOfThreeQuicksort[T any](A []T, p, r int, cmp func(a, b T) int) {
+	for r-p+1 > 2 {
+		medianOfThree(A, p, p+(r-p)/2, r, cmp)
+		q := PartitionFunc(A, p, r, cmp)
+		medianOfThreeQuicksort(A, p, q-1, cmp)
+		p = q + 1
+	}
+	if p < r && cmp(A[p], A[r]) > 0 {
+		A[p], A[r] = A[r], A[p]
+	}
+}
+
+// genInts returns the slice of value(i) for i from 0 to n-1.
+func genInts(n int, value func(i int) int) []int {
+	A := make([]int, n)
+	for i := range A {
+		A[i] = value(i)
+	}
+	return A
+}
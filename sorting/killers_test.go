@@ -0,0 +1,97 @@
+package sorting
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+// countComparisons runs sort on a copy of in and returns the number of
+// comparisons it made, failing t if the result is not sorted.
+func countComparisons(t *testing.T, in []int, sort func(A []int, cmp func(a, b int) int)) int {
+	t.Helper()
+	A := slices.Clone(in)
+	comparisons := 0
+	sort(A, func(a, b int) int {
+		comparisons++
+		return cmpInt(a, b)
+	})
+	if !slices.IsSorted(A) {
+		t.Fatal("output is not sorted")
+	}
+	return comparisons
+}
+
+func quicksortAll(A []int, cmp func(a, b int) int) {
+	QuicksortFunc(A, 0, len(A)-1, cmp)
+}
+
+func randomizedQuicksortAll(A []int, cmp func(a, b int) int) {
+	RandomizedQuicksortFunc(A, 0, len(A)-1, cmp)
+}
+
+func TestKillers(t *testing.T) {
+	const n = 2000
+	quadratic := n * (n - 1) / 2
+	nlgn := n * bits.Len(n)
+
+	antiQuicksort, qAdaptive := Antiqsort(n, quicksortAll)
+	_, rAdaptive := Antiqsort(n, randomizedQuicksortAll)
+
+	tests := []struct {
+		name  string
+		input []int
+		// Bounds on the comparisons of Quicksort and RandomizedQuicksort.
+		qMin, rMax int
+	}{
+		{"sorted", SortedKiller(n), quadratic, 4 * nlgn},
+		{"all-equal", AllEqualKiller(n), quadratic, quadratic},
+		{"median-of-three", MedianOfThreeKiller(n), 0, 4 * nlgn},
+		{"antiqsort(Quicksort)", antiQuicksort, qAdaptive, 4 * nlgn},
+	}
+
+	t.Logf("%-22s %12s %20s", "input", "Quicksort", "RandomizedQuicksort")
+	for _, tt := range tests {
+		q := countComparisons(t, tt.input, quicksortAll)
+		r := countComparisons(t, tt.input, randomizedQuicksortAll)
+		t.Logf("%-22s %12d %20d", tt.name, q, r)
+
+		if q < tt.qMin {
+			t.Errorf("Quicksort made %d comparisons on %s input, want at least %d", q, tt.name, tt.qMin)
+		}
+		if r > tt.rMax {
+			t.Errorf("RandomizedQuicksort made %d comparisons on %s input, want at most %d", r, tt.name, tt.rMax)
+		}
+	}
+	t.Logf("%-22s %12d %20d", "antiqsort (adaptive)", qAdaptive, rAdaptive)
+
+	// The adversary defeats both during its own run.
+	if qAdaptive < quadratic/2 {
+		t.Errorf("Antiqsort forced %d comparisons on Quicksort, want at least %d", qAdaptive, quadratic/2)
+	}
+	if rAdaptive < quadratic/4 {
+		t.Errorf("Antiqsort forced %d comparisons on RandomizedQuicksort, want at least %d", rAdaptive, quadratic/4)
+	}
+}
+
+func TestMedianOfThreeKiller(t *testing.T) {
+	const n = 2000
+	in := MedianOfThreeKiller(n)
+	if !slices.Equal(in, MedianOfThreeKiller(n)) {
+		t.Error("MedianOfThreeKiller is not deterministic")
+	}
+
+	m := countComparisons(t, in, func(A []int, cmp func(a, b int) int) {
+		medianOfThreeQuicksort(A, 0, len(A)-1, cmp)
+	})
+	if limit := n * n / 8; m < limit {
+		t.Errorf("median-of-three quicksort made %d comparisons on its killer, want at least %d", m, limit)
+	}
+
+	// Introsort's heapsort fallback is what saves it.
+	intro := countComparisons(t, in, IntrosortFunc[int])
+	if limit := 4 * n * bits.Len(n); intro > limit {
+		t.Errorf("Introsort made %d comparisons on the median-of-three killer, want at most %d", intro, limit)
+	}
+	t.Logf("median-of-three quicksort: %d comparisons, Introsort: %d", m, intro)
+}
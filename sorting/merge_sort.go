@@ -0,0 +1,96 @@
+package sorting
+
+import "cmp"
+
+// MergeSort returns a sorted copy of the given slice using the Merge Sort algorithm.
+//
+// It recursively splits the input slice into halves, sorts each half, and merges them.
+// The sort is stable: Merge always takes from the left half on ties.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n)
+func MergeSort[T cmp.Ordered](A []T) []T {
+	return MergeSortFunc(A, cmp.Compare[T])
+}
+
+// MergeSortFunc returns a copy of the given slice sorted in ascending order
+// as determined by the cmp function, using the Merge Sort algorithm.
+//
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b, as in slices.SortFunc.
+//
+// The sort is stable.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n)
+func MergeSortFunc[T any](A []T, cmp func(a, b T) int) []T {
+	return mergeSort(A, 0, 1, cmp, nil)
+}
+
+// mergeSort is MergeSortFunc on the subarray of the original input that
+// starts at index lo, at recursion depth depth, reporting its work to pr.
+func mergeSort[T any](A []T, lo, depth int, cmp func(a, b T) int, pr *probe[T]) []T {
+	pr.enter(depth)
+	// Base case: if array has 0 or 1 element, it's already sorted
+	if len(A) <= 1 {
+		return A
+	}
+
+	middle := len(A) / 2
+	left := mergeSort(A[:middle], lo, depth+1, cmp, pr)         // Recursively sort left half
+	right := mergeSort(A[middle:], lo+middle, depth+1, cmp, pr) // Recursively sort right half
+
+	// Merge the sorted halves and return
+	pr.merge(lo, lo+middle, lo+len(A))
+	return merge(left, right, lo, cmp, pr)
+}
+
+// Merge combines two sorted slices into a single sorted slice.
+//
+// It compares elements from both slices and appends the smaller one to the result.
+// Time complexity: O(n) where n = len(Left) + len(Right)
+// Space complexity: O(n)
+func Merge[T cmp.Ordered](Left, Right []T) []T {
+	return MergeFunc(Left, Right, cmp.Compare[T])
+}
+
+// MergeFunc combines two slices, each sorted as determined by the cmp
+// function, into a single sorted slice.
+//
+// On ties the element from Left is taken first, which is what makes
+// MergeSortFunc stable.
+//
+// Time complexity: O(n) where n = len(Left) + len(Right)
+// Space complexity: O(n)
+func MergeFunc[T any](Left, Right []T, cmp func(a, b T) int) []T {
+	return merge(Left, Right, 0, cmp, nil)
+}
+
+// merge is MergeFunc for two adjacent subarrays of the original input,
+// Left starting at index lo, reporting its work to pr.
+func merge[T any](Left, Right []T, lo int, cmp func(a, b T) int, pr *probe[T]) []T {
+	result := []T{}
+	i, j := 0, 0
+	mid := lo + len(Left) // index of Right[0]
+
+	// Merge elements while both arrays have remaining items
+	for i < len(Left) && j < len(Right) {
+		pr.compare(lo+i, mid+j)
+		if cmp(Left[i], Right[j]) <= 0 {
+			result = pr.append(result, lo, Left[i])
+			i++
+		} else {
+			// Right[j] is smaller than every element in Left[i:]
+			pr.invert(len(Left) - i)
+			result = pr.append(result, lo, Right[j])
+			j++
+		}
+	}
+
+	// Append any remaining elements from Left (if any)
+	result = pr.append(result, lo, Left[i:]...)
+	// Append any remaining elements from Right (if any)
+	result = pr.append(result, lo, Right[j:]...)
+
+	return result
+}
@@ -0,0 +1,109 @@
+package sorting
+
+import "cmp"
+
+// MergeSortOptions configures MergeSortBuffered.
+type MergeSortOptions struct {
+	// BottomUp sorts iteratively, merging runs of width 1, 2, 4, ...
+	// instead of recursing on halves.
+	BottomUp bool
+
+	// Cutoff is the length at or below which a run is sorted with
+	// InsertionSort instead of being split further. Zero disables it.
+	Cutoff int
+}
+
+// MergeSortBuffered sorts the given slice in place using the Merge Sort
+// algorithm and a single scratch buffer.
+//
+// Unlike MergeSort, which builds a new slice at every level of recursion,
+// every merge copies its left half into buf and merges back into A, so the
+// whole sort uses one buffer of len(A) elements. If buf is shorter than A a
+// new buffer is allocated; passing the same buf to repeated calls makes
+// them allocation-free.
+//
+// The sort is stable.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n) – the scratch buffer
+func MergeSortBuffered[T cmp.Ordered](A, buf []T, opts MergeSortOptions) {
+	MergeSortBufferedFunc(A, buf, opts, cmp.Compare[T])
+}
+
+// MergeSortBufferedFunc is MergeSortBuffered with elements ordered by
+// the cmp function.
+func MergeSortBufferedFunc[T any](A, buf []T, opts MergeSortOptions, cmp func(a, b T) int) {
+	if len(buf) < len(A) {
+		buf = make([]T, len(A))
+	}
+	if opts.BottomUp {
+		mergeSortBottomUp(A, buf, opts.Cutoff, cmp)
+	} else {
+		mergeSortTopDown(A, buf, 0, len(A), opts.Cutoff, cmp)
+	}
+}
+
+// mergeSortTopDown sorts A[p:r] by recursively sorting both halves and
+// merging them through buf.
+func mergeSortTopDown[T any](A, buf []T, p, r, cutoff int, cmp func(a, b T) int) {
+	if r-p <= 1 {
+		return
+	}
+	if r-p <= cutoff {
+		InsertionSortFunc(A[p:r], cmp)
+		return
+	}
+
+	q := p + (r-p)/2
+	mergeSortTopDown(A, buf, p, q, cutoff, cmp)
+	mergeSortTopDown(A, buf, q, r, cutoff, cmp)
+	mergeWithBuffer(A, buf, p, q, r, cmp)
+}
+
+// mergeSortBottomUp sorts A without recursion. Blocks of cutoff elements
+// are first sorted with InsertionSort, then adjacent runs are merged with
+// doubling widths until a single run remains.
+func mergeSortBottomUp[T any](A, buf []T, cutoff int, cmp func(a, b T) int) {
+	n := len(A)
+	width := 1
+	if cutoff > 1 {
+		width = cutoff
+		for lo := 0; lo < n; lo += width {
+			InsertionSortFunc(A[lo:min(lo+width, n)], cmp)
+		}
+	}
+
+	for ; width < n; width *= 2 {
+		for lo := 0; lo+width < n; lo += 2 * width {
+			mergeWithBuffer(A, buf, lo, lo+width, min(lo+2*width, n), cmp)
+		}
+	}
+}
+
+// mergeWithBuffer merges the sorted runs A[p:q] and A[q:r] in place.
+//
+// Only the left run is copied into buf[p:q]; the right run is read
+// directly from A, which is safe because the write position never
+// overtakes the next unread element of the right run. Ties take the
+// left element first, keeping the merge stable.
+//
+// Time complexity: O(r - p)
+// Space complexity: O(1) – beyond the shared buffer
+func mergeWithBuffer[T any](A, buf []T, p, q, r int, cmp func(a, b T) int) {
+	copy(buf[p:q], A[p:q])
+
+	i, j, k := p, q, p
+	for i < q && j < r {
+		if cmp(buf[i], A[j]) <= 0 {
+			A[k] = buf[i]
+			i++
+		} else {
+			A[k] = A[j]
+			j++
+		}
+		k++
+	}
+
+	// Whatever is left of the right run is already in place.
+	copy(A[k:], buf[i:q])
+}
@@ -0,0 +1,98 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var mergeSortOptions = []MergeSortOptions{
+	{},
+	{Cutoff: 4},
+	{BottomUp: true},
+	{BottomUp: true, Cutoff: 3},
+}
+
+func TestMergeSortBuffered(t *testing.T) {
+	for _, opts := range mergeSortOptions {
+		for _, in := range sortInputs {
+			got := slices.Clone(in)
+			MergeSortBuffered(got, nil, opts)
+
+			want := slices.Clone(in)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("MergeSortBuffered(%v, %+v) = %v, want %v", in, opts, got, want)
+			}
+		}
+	}
+}
+
+func TestMergeSortBufferedFuncStable(t *testing.T) {
+	for _, opts := range mergeSortOptions {
+		R := records(2, 1, 2, 0, 1, 2, 0, 0, 1, 2, 1)
+		MergeSortBufferedFunc(R, nil, opts, byKey)
+		if !slices.IsSortedFunc(R, byKey) || !isStable(R) {
+			t.Errorf("MergeSortBufferedFunc(%+v) is not stable: %v", opts, R)
+		}
+	}
+}
+
+func TestMergeSortBufferedReusesBuffer(t *testing.T) {
+	A := randomInts(1000)
+	buf := make([]int, len(A))
+	allocs := testing.AllocsPerRun(10, func() {
+		MergeSortBuffered(A, buf, MergeSortOptions{Cutoff: 16})
+	})
+	if allocs != 0 {
+		t.Errorf("MergeSortBuffered with a caller buffer allocated %v times per run", allocs)
+	}
+}
+
+// randomInts returns n pseudo-random ints from a fixed seed.
+func randomInts(n int) []int {
+	rng := rand.New(rand.NewSource(1))
+	A := make([]int, n)
+	for i := range A {
+		A[i] = rng.Intn(n)
+	}
+	return A
+}
+
+const benchSize = 100_000
+
+func BenchmarkMergeSort(b *testing.B) {
+	in := randomInts(benchSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MergeSort(in)
+	}
+}
+
+func benchmarkMergeSortBuffered(b *testing.B, opts MergeSortOptions) {
+	in := randomInts(benchSize)
+	A := make([]int, len(in))
+	buf := make([]int, len(in))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		MergeSortBuffered(A, buf, opts)
+	}
+}
+
+func BenchmarkMergeSortBufferedTopDown(b *testing.B) {
+	benchmarkMergeSortBuffered(b, MergeSortOptions{})
+}
+
+func BenchmarkMergeSortBufferedTopDownCutoff(b *testing.B) {
+	benchmarkMergeSortBuffered(b, MergeSortOptions{Cutoff: 16})
+}
+
+func BenchmarkMergeSortBufferedBottomUp(b *testing.B) {
+	benchmarkMergeSortBuffered(b, MergeSortOptions{BottomUp: true})
+}
+
+func BenchmarkMergeSortBufferedBottomUpCutoff(b *testing.B) {
+	benchmarkMergeSortBuffered(b, MergeSortOptions{BottomUp: true, Cutoff: 16})
+}
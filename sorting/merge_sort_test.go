@@ -0,0 +1,30 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeSort(t *testing.T) {
+	for _, in := range sortInputs {
+		got := MergeSort(slices.Clone(in))
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("MergeSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+
+	floats := MergeSort([]float64{2.5, -1, 0, 2.5, 1e9})
+	if !slices.IsSorted(floats) {
+		t.Errorf("MergeSort on floats = %v, not sorted", floats)
+	}
+}
+
+func TestMergeSortFuncStable(t *testing.T) {
+	R := MergeSortFunc(records(2, 1, 2, 0, 1, 2, 0, 0), byKey)
+	if !slices.IsSortedFunc(R, byKey) || !isStable(R) {
+		t.Errorf("MergeSortFunc is not stable: %v", R)
+	}
+}
@@ -0,0 +1,95 @@
+package sorting
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Median returns the lower median of A, the element of rank ⌊(n+1)/2⌋
+// as defined in CLRS, using Select.
+//
+// A is rearranged as by Select. It panics if A is empty.
+//
+// Time complexity: O(n)
+// Space complexity: O(log n)
+func Median[T cmp.Ordered](A []T) T {
+	return MedianFunc(A, cmp.Compare[T])
+}
+
+// MedianFunc is Median with elements ordered by the cmp function.
+func MedianFunc[T any](A []T, cmp func(a, b T) int) T {
+	return SelectFunc(A, 0, len(A)-1, (len(A)+1)/2, cmp)
+}
+
+// Quantiles returns, for every q in qs, the q-quantile of A by the
+// nearest-rank definition: the element of rank ⌈q·n⌉, or the smallest
+// element for q = 0. The results are in the order of qs.
+//
+// The quantiles are selected in ascending order with Select, each one
+// within the part of A that lies after the previous one, so A is
+// rearranged.
+//
+// It panics if A is empty or any q is outside [0, 1].
+//
+// Time complexity: O(n·m) for m quantiles
+// Space complexity: O(m + log n)
+func Quantiles[T cmp.Ordered](A []T, qs ...float64) []T {
+	return QuantilesFunc(A, cmp.Compare[T], qs...)
+}
+
+// QuantilesFunc is Quantiles with elements ordered by the cmp function.
+func QuantilesFunc[T any](A []T, cmp func(a, b T) int, qs ...float64) []T {
+	n := len(A)
+	if n == 0 && len(qs) > 0 {
+		panic("quantiles: empty input")
+	}
+
+	// order lists the indices of qs by increasing quantile.
+	targets := make([]int, len(qs))
+	order := make([]int, len(qs))
+	for k, q := range qs {
+		if !(q >= 0 && q <= 1) {
+			panic("quantiles: quantile outside [0, 1]")
+		}
+		targets[k] = max(int(math.Ceil(q*float64(n)))-1, 0)
+		order[k] = k
+	}
+	slices.SortFunc(order, func(a, b int) int { return targets[a] - targets[b] })
+
+	result := make([]T, len(qs))
+	p := 0
+	for _, k := range order {
+		selectAt(A, p, n-1, targets[k], cmp)
+		result[k] = A[targets[k]]
+		p = targets[k]
+	}
+	return result
+}
+
+// TopK returns the k largest elements of A in descending order, or all
+// of A if k > len(A).
+//
+// Select moves the k largest elements to the end of A, and only those
+// are sorted, with HeapSort, so A is rearranged.
+//
+// Time complexity: O(n + k log k)
+// Space complexity: O(k + log n)
+func TopK[T cmp.Ordered](A []T, k int) []T {
+	return TopKFunc(A, k, cmp.Compare[T])
+}
+
+// TopKFunc is TopK with elements ordered by the cmp function; the k
+// greatest elements by cmp are returned, greatest first.
+func TopKFunc[T any](A []T, k int, cmp func(a, b T) int) []T {
+	n := len(A)
+	k = min(max(k, 0), n)
+	if k == 0 {
+		return []T{}
+	}
+
+	selectAt(A, 0, n-1, n-k, cmp)
+	top := slices.Clone(A[n-k:])
+	HeapSortFunc(top, func(a, b T) int { return cmp(b, a) })
+	return top
+}
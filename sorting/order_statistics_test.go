@@ -0,0 +1,76 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		in   []int
+		want int
+	}{
+		{[]int{5}, 5},
+		{[]int{2, 1}, 1},
+		{[]int{3, 1, 2}, 2},
+		{[]int{4, 1, 3, 2}, 2},
+		{[]int{7, 7, 1, 7}, 7},
+	}
+	for _, tt := range tests {
+		if got := Median(slices.Clone(tt.in)); got != tt.want {
+			t.Errorf("Median(%v) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	in := randomInts(1000)
+	sorted := slices.Clone(in)
+	slices.Sort(sorted)
+
+	qs := []float64{0.99, 0, 0.5, 0.25, 1, 0.5, 0.001}
+	got := Quantiles(slices.Clone(in), qs...)
+	want := []int{sorted[989], sorted[0], sorted[499], sorted[249], sorted[999], sorted[499], sorted[0]}
+	if !slices.Equal(got, want) {
+		t.Errorf("Quantiles(%v) = %v, want %v", qs, got, want)
+	}
+
+	if got := Quantiles([]int{}); len(got) != 0 {
+		t.Errorf("Quantiles with no quantiles = %v, want none", got)
+	}
+	for _, q := range []float64{-0.1, 1.5} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Quantiles with q = %v did not panic", q)
+				}
+			}()
+			Quantiles([]int{1, 2, 3}, q)
+		}()
+	}
+}
+
+func TestTopK(t *testing.T) {
+	in := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{-1, []int{}},
+		{0, []int{}},
+		{1, []int{9}},
+		{4, []int{9, 6, 5, 5}},
+		{20, []int{9, 6, 5, 5, 5, 4, 3, 3, 2, 1, 1}},
+	}
+	for _, tt := range tests {
+		if got := TopK(slices.Clone(in), tt.k); !slices.Equal(got, tt.want) {
+			t.Errorf("TopK(%v, %d) = %v, want %v", in, tt.k, got, tt.want)
+		}
+	}
+
+	// By a reversed comparison, the "largest" are the smallest.
+	got := TopKFunc(slices.Clone(in), 3, func(a, b int) int { return b - a })
+	if want := []int{1, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("TopKFunc with reversed cmp = %v, want %v", got, want)
+	}
+}
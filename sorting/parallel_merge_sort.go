@@ -0,0 +1,186 @@
+package sorting
+
+import (
+	"cmp"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// ParallelMergeSortOptions configures ParallelMergeSort.
+type ParallelMergeSortOptions struct {
+	// Grain is the subproblem size at or below which sorting and merging
+	// are done serially. Zero or less selects a default of 2048.
+	Grain int
+
+	// Workers caps the number of goroutines working at the same time,
+	// including the caller's. Zero or less selects runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// ParallelMergeSort returns a sorted copy of the given slice using the
+// fork-join P-MERGE-SORT algorithm from CLRS.
+//
+// Both halves are sorted in parallel and then merged in parallel by
+// P-MERGE: the median of the larger run is located in the smaller run by
+// binary search, its final position is fixed, and the two independent
+// merges on either side of it are forked again.
+//
+// The sort is stable, so the result is identical to MergeSort(A).
+//
+// Work: Θ(n log n)
+// Span: Θ(log³ n)
+// Space complexity: O(n)
+func ParallelMergeSort[T cmp.Ordered](A []T, opts ParallelMergeSortOptions) []T {
+	return ParallelMergeSortFunc(A, opts, cmp.Compare[T])
+}
+
+// ParallelMergeSortFunc is ParallelMergeSort with elements ordered by the
+// cmp function. The result is identical to MergeSortFunc(A, cmp).
+func ParallelMergeSortFunc[T any](A []T, opts ParallelMergeSortOptions, cmp func(a, b T) int) []T {
+	if opts.Grain <= 0 {
+		opts.Grain = 2048
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	s := &parallelMergeSorter[T]{
+		cmp:   cmp,
+		grain: opts.Grain,
+		// The caller's goroutine is one of the workers.
+		tokens: make(chan struct{}, opts.Workers-1),
+	}
+
+	result := slices.Clone(A)
+	s.sort(result, make([]T, len(A)), 0, len(A))
+	return result
+}
+
+// parallelMergeSorter holds the state shared by one ParallelMergeSort call.
+type parallelMergeSorter[T any] struct {
+	cmp    func(a, b T) int
+	grain  int
+	tokens chan struct{} // one token per goroutine that may be forked
+}
+
+// fork runs f and g, in parallel if a worker is free and serially otherwise.
+func (s *parallelMergeSorter[T]) fork(f, g func()) {
+	select {
+	case s.tokens <- struct{}{}:
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-s.tokens }()
+			f()
+		}()
+		g()
+		wg.Wait()
+	default:
+		f()
+		g()
+	}
+}
+
+// sort sorts A[p:r] in place, using B[p:r] as scratch space (P-MERGE-SORT).
+func (s *parallelMergeSorter[T]) sort(A, B []T, p, r int) {
+	if r-p <= s.grain {
+		mergeSortTopDown(A, B, p, r, 0, s.cmp)
+		return
+	}
+
+	q := p + (r-p)/2
+	s.fork(
+		func() { s.sort(A, B, p, q) },
+		func() { s.sort(A, B, q, r) },
+	)
+
+	copy(B[p:r], A[p:r])
+	s.merge(B, p, q, q, r, A, p)
+}
+
+// merge merges the sorted runs B[p1:r1] and B[p2:r2] into A starting at
+// index p3 (P-MERGE). Ties are resolved in favour of the first run.
+func (s *parallelMergeSorter[T]) merge(B []T, p1, r1, p2, r2 int, A []T, p3 int) {
+	n1, n2 := r1-p1, r2-p2
+	if n1+n2 <= s.grain {
+		s.serialMerge(B, p1, r1, p2, r2, A, p3)
+		return
+	}
+
+	var q1, q2 int
+	if n1 >= n2 {
+		// x = median of the first run; elements of the second run
+		// equal to x must stay behind it.
+		q1 = p1 + n1/2
+		q2 = p2 + lowerBound(B[p2:r2], B[q1], s.cmp)
+		A[p3+(q1-p1)+(q2-p2)] = B[q1]
+		s.forkMerge(B, p1, q1, p2, q2, q1+1, r1, q2, r2, A, p3)
+	} else {
+		// x = median of the second run; elements of the first run
+		// equal to x must stay in front of it.
+		q2 = p2 + n2/2
+		q1 = p1 + upperBound(B[p1:r1], B[q2], s.cmp)
+		A[p3+(q1-p1)+(q2-p2)] = B[q2]
+		s.forkMerge(B, p1, q1, p2, q2, q1, r1, q2+1, r2, A, p3)
+	}
+}
+
+// forkMerge merges the two independent halves left by merge in parallel:
+// B[p1:q1] with B[p2:q2] into A from p3, and B[s1:r1] with B[s2:r2] into
+// the positions after the element placed between them.
+func (s *parallelMergeSorter[T]) forkMerge(B []T, p1, q1, p2, q2, s1, r1, s2, r2 int, A []T, p3 int) {
+	q3 := p3 + (q1 - p1) + (q2 - p2) + 1
+	s.fork(
+		func() { s.merge(B, p1, q1, p2, q2, A, p3) },
+		func() { s.merge(B, s1, r1, s2, r2, A, q3) },
+	)
+}
+
+// serialMerge is the ordinary two-finger merge of B[p1:r1] and B[p2:r2]
+// into A starting at p3.
+func (s *parallelMergeSorter[T]) serialMerge(B []T, p1, r1, p2, r2 int, A []T, p3 int) {
+	for p1 < r1 && p2 < r2 {
+		if s.cmp(B[p1], B[p2]) <= 0 {
+			A[p3] = B[p1]
+			p1++
+		} else {
+			A[p3] = B[p2]
+			p2++
+		}
+		p3++
+	}
+	p3 += copy(A[p3:], B[p1:r1])
+	copy(A[p3:], B[p2:r2])
+}
+
+// lowerBound returns the index of the first element of the sorted slice A
+// that is not less than x, or len(A) if there is none.
+func lowerBound[T any](A []T, x T, cmp func(a, b T) int) int {
+	lo, hi := 0, len(A)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(A[mid], x) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// upperBound returns the index of the first element of the sorted slice A
+// that is greater than x, or len(A) if there is none.
+func upperBound[T any](A []T, x T, cmp func(a, b T) int) int {
+	lo, hi := 0, len(A)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(A[mid], x) <= 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
@@ -0,0 +1,57 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestParallelMergeSort(t *testing.T) {
+	for _, in := range sortInputs {
+		got := ParallelMergeSort(in, ParallelMergeSortOptions{Grain: 2, Workers: 4})
+		if want := MergeSort(slices.Clone(in)); !slices.Equal(got, want) {
+			t.Errorf("ParallelMergeSort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestParallelMergeSortMatchesMergeSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for _, n := range []int{10, 100, 1000, 10000} {
+		// Few distinct keys, so that any instability would show up.
+		keys := make([]int, n)
+		for i := range keys {
+			keys[i] = rng.Intn(8)
+		}
+		in := records(keys...)
+		want := MergeSortFunc(slices.Clone(in), byKey)
+
+		for _, opts := range []ParallelMergeSortOptions{
+			{},
+			{Grain: 1, Workers: 1},
+			{Grain: 4, Workers: 3},
+			{Grain: 16, Workers: 64},
+		} {
+			got := ParallelMergeSortFunc(in, opts, byKey)
+			if !slices.Equal(got, want) {
+				t.Errorf("n=%d %+v: ParallelMergeSortFunc differs from MergeSortFunc", n, opts)
+			}
+		}
+	}
+}
+
+func TestParallelMergeSortLeavesInputUnchanged(t *testing.T) {
+	in := []int{5, 4, 3, 2, 1}
+	ParallelMergeSort(in, ParallelMergeSortOptions{Grain: 1})
+	if !slices.Equal(in, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("ParallelMergeSort modified its input: %v", in)
+	}
+}
+
+func BenchmarkParallelMergeSort(b *testing.B) {
+	in := randomInts(benchSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParallelMergeSort(in, ParallelMergeSortOptions{})
+	}
+}
@@ -0,0 +1,174 @@
+package sorting
+
+import (
+	"cmp"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelQuicksortOptions configures ParallelQuicksort.
+type ParallelQuicksortOptions struct {
+	// Grain is the subarray size at or below which both sides of a
+	// partition are sorted serially. Zero or less selects a default of
+	// 2048.
+	Grain int
+
+	// Cutoff is the subarray size at or below which InsertionSort is
+	// used. Zero or less selects a default of 16.
+	Cutoff int
+
+	// Workers caps the number of goroutines working at the same time,
+	// including the caller's. Zero or less selects runtime.GOMAXPROCS(0).
+	Workers int
+
+	// Scheme is the partitioning scheme. The zero value is Lomuto, as
+	// used by Quicksort; ThreeWay copes best with many equal keys.
+	Scheme PartitionScheme
+}
+
+// ParallelQuicksort sorts A[p..r] in ascending order using quicksort
+// with the two sides of every partition sorted in parallel.
+//
+// Once a subarray is partitioned, its two sides are independent, so the
+// smaller one is handed to a new goroutine while the current one sorts
+// the larger. Workers-1 tokens bound the goroutines that may run at
+// once; when none is free, or the subarray has at most Grain elements,
+// both sides are sorted serially instead. Subarrays of at most Cutoff
+// elements are finished with InsertionSort.
+//
+// Pivots are the median of the first, middle and last elements.
+//
+// The sort is not stable.
+//
+// Work (average): O(n log n)
+// Span (average): O(n), as the first partition alone is linear
+// Work (worst case): O(n²)
+// Space complexity: O(log n) stack per goroutine on average
+func ParallelQuicksort[T cmp.Ordered](A []T, p, r int, opts ParallelQuicksortOptions) {
+	ParallelQuicksortFunc(A, p, r, opts, cmp.Compare[T])
+}
+
+// ParallelQuicksortFunc is ParallelQuicksort with elements ordered by
+// the cmp function.
+func ParallelQuicksortFunc[T any](A []T, p, r int, opts ParallelQuicksortOptions, cmp func(a, b T) int) {
+	parallelQuicksort(A, p, r, opts, cmp)
+}
+
+// parallelQuicksort sorts A[p..r] and returns the sorter, whose
+// counters the tests inspect.
+func parallelQuicksort[T any](A []T, p, r int, opts ParallelQuicksortOptions, cmp func(a, b T) int) *parallelQuicksorter[T] {
+	if opts.Grain <= 0 {
+		opts.Grain = 2048
+	}
+	if opts.Cutoff <= 0 {
+		opts.Cutoff = 16
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	s := &parallelQuicksorter[T]{
+		cmp:    cmp,
+		grain:  opts.Grain,
+		cutoff: opts.Cutoff,
+		scheme: opts.Scheme,
+		// The caller's goroutine is one of the workers.
+		tokens: make(chan struct{}, opts.Workers-1),
+	}
+	if p < r {
+		s.sort(A, p, r)
+	}
+	return s
+}
+
+// parallelQuicksorter holds the state shared by one ParallelQuicksort
+// call.
+type parallelQuicksorter[T any] struct {
+	cmp    func(a, b T) int
+	grain  int
+	cutoff int
+	scheme PartitionScheme
+	tokens chan struct{} // one token per goroutine that may be forked
+
+	running atomic.Int64 // forked goroutines running now
+	peak    atomic.Int64 // most forked goroutines ever running at once
+	forks   atomic.Int64 // forked goroutines in total
+}
+
+// sort sorts A[p..r]. It recurses, or forks, on the smaller side of
+// every partition and loops on the larger one, and returns only once
+// every goroutine it forked has finished.
+func (s *parallelQuicksorter[T]) sort(A []T, p, r int) {
+	var wg sync.WaitGroup
+	for r-p+1 > s.cutoff {
+		n := r - p + 1
+		lo, hi := s.partition(A, p, r)
+
+		sp, sr := p, lo
+		if lo-p < r-hi {
+			p = hi
+		} else {
+			sp, sr = hi, r
+			r = lo
+		}
+
+		if n > s.grain && s.acquire() {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer s.release()
+				s.sort(A, sp, sr)
+			}()
+		} else {
+			s.sort(A, sp, sr)
+		}
+	}
+	InsertionSortFunc(A[p:r+1], s.cmp)
+	wg.Wait()
+}
+
+// partition partitions A[p..r] around the median of three by the
+// sorter's scheme, and returns lo and hi such that A[p..lo] and A[hi..r]
+// remain to be sorted.
+func (s *parallelQuicksorter[T]) partition(A []T, p, r int) (lo, hi int) {
+	medianOfThree(A, p, p+(r-p)/2, r, s.cmp)
+
+	switch s.scheme {
+	case Hoare:
+		A[p], A[r] = A[r], A[p]
+		q := HoarePartitionFunc(A, p, r, s.cmp)
+		return q, q + 1
+	case ThreeWay:
+		q, t := ThreeWayPartitionFunc(A, p, r, s.cmp)
+		return q - 1, t + 1
+	default:
+		q := PartitionFunc(A, p, r, s.cmp)
+		return q - 1, q + 1
+	}
+}
+
+// acquire takes a token for a new goroutine, if one is free.
+func (s *parallelQuicksorter[T]) acquire() bool {
+	select {
+	case s.tokens <- struct{}{}:
+	default:
+		return false
+	}
+
+	running := s.running.Add(1)
+	for {
+		peak := s.peak.Load()
+		if running <= peak || s.peak.CompareAndSwap(peak, running) {
+			break
+		}
+	}
+	s.forks.Add(1)
+	return true
+}
+
+// release returns the token of a finished goroutine.
+func (s *parallelQuicksorter[T]) release() {
+	s.running.Add(-1)
+	<-s.tokens
+}
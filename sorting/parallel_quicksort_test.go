@@ -0,0 +1,70 @@
+package sorting
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelQuicksort(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(10000)) {
+		got := slices.Clone(in)
+		ParallelQuicksort(got, 0, len(got)-1, ParallelQuicksortOptions{Grain: 64, Workers: 4})
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("ParallelQuicksort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestParallelQuicksortWorkers(t *testing.T) {
+	in := randomInts(1 << 16)
+	for _, scheme := range []PartitionScheme{Lomuto, Hoare, ThreeWay} {
+		for _, workers := range []int{1, 2, 3, 8} {
+			t.Run(fmt.Sprintf("%v/%d", scheme, workers), func(t *testing.T) {
+				// Besides the sorter's own count, measure how many
+				// goroutines are inside cmp at the same time.
+				var inside, most atomic.Int64
+				cmp := func(a, b int) int {
+					n := inside.Add(1)
+					for m := most.Load(); n > m && !most.CompareAndSwap(m, n); m = most.Load() {
+					}
+					inside.Add(-1)
+					return cmpInt(a, b)
+				}
+
+				A := slices.Clone(in)
+				opts := ParallelQuicksortOptions{Grain: 64, Workers: workers, Scheme: scheme}
+				s := parallelQuicksort(A, 0, len(A)-1, opts, cmp)
+
+				if !slices.IsSorted(A) {
+					t.Fatal("ParallelQuicksort did not sort")
+				}
+				if peak := s.peak.Load(); peak > int64(workers-1) {
+					t.Errorf("%d forked goroutines ran at once, want at most %d", peak, workers-1)
+				}
+				if m := most.Load(); m > int64(workers) {
+					t.Errorf("%d goroutines compared at once, want at most %d", m, workers)
+				}
+				if forks := s.forks.Load(); (forks > 0) != (workers > 1) {
+					t.Errorf("%d goroutines forked with %d workers", forks, workers)
+				}
+				if running := s.running.Load(); running != 0 {
+					t.Errorf("%d forked goroutines still running after the sort", running)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkParallelQuicksort(b *testing.B) {
+	in := randomInts(benchSize)
+	A := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		ParallelQuicksort(A, 0, len(A)-1, ParallelQuicksortOptions{})
+	}
+}
@@ -0,0 +1,233 @@
+package sorting
+
+import (
+	"cmp"
+	"math/rand"
+	"strconv"
+)
+
+// PartitionScheme selects how QuicksortWith and RandomizedQuicksortWith
+// partition a subarray around its pivot.
+type PartitionScheme int
+
+const (
+	// Lomuto is the scheme of Partition. Every element equal to the
+	// pivot goes to the low side, so n equal keys take Θ(n²) time.
+	Lomuto PartitionScheme = iota
+
+	// Hoare is the scheme of HoarePartition. Both scans stop at keys
+	// equal to the pivot, so equal keys are split evenly between the
+	// two sides and n equal keys take Θ(n log n) time.
+	Hoare
+
+	// ThreeWay is the scheme of ThreeWayPartition. Keys equal to the
+	// pivot are set aside in the middle and never looked at again, so
+	// n keys with only k distinct values take O(n log k) time.
+	ThreeWay
+)
+
+// String returns the name of the scheme.
+func (s PartitionScheme) String() string {
+	switch s {
+	case Lomuto:
+		return "lomuto"
+	case Hoare:
+		return "hoare"
+	case ThreeWay:
+		return "three-way"
+	}
+	return "PartitionScheme(" + strconv.Itoa(int(s)) + ")"
+}
+
+// QuicksortOptions configures QuicksortWith, RandomizedQuicksortWith,
+// RandomizedDualPivotQuicksortWith and FuzzySortWith.
+//
+// The remaining fields control where the randomized sorts take their
+// pivots from; QuicksortWith ignores them. By default pivots are drawn
+// from the global source of math/rand, as by RandomizedQuicksort.
+type QuicksortOptions struct {
+	// Scheme is the partitioning scheme. The zero value is Lomuto, as
+	// used by Quicksort.
+	Scheme PartitionScheme
+
+	// Rand, if not nil, is the source of random pivots. A *rand.Rand is
+	// not safe for concurrent use, so concurrent sorts each need their
+	// own; unlike the global source it is never contended.
+	Rand *rand.Rand
+
+	// Seeded, if Rand is nil, makes the sort draw its pivots from a new
+	// source seeded with Seed, so that runs with the same Seed choose
+	// the same pivots. Every Seed, zero included, is a seed of its own.
+	Seeded bool
+	Seed   int64
+
+	// RecordPivots, if not nil, has the index of every pivot chosen
+	// appended to it, in the order they were chosen.
+	RecordPivots *[]int
+
+	// ReplayPivots, if not nil, supplies the pivot indices instead of a
+	// random source. Given the pivots recorded by an earlier sort of the
+	// same input with the same Scheme, the sort repeats that run exactly.
+	// The sort panics if the replayed pivots run out or one lies outside
+	// the subarray being partitioned.
+	ReplayPivots []int
+}
+
+// QuicksortWith sorts A[p..r] in ascending order using quicksort with
+// the partitioning scheme selected by opts.
+//
+// The pivot is A[r] for Lomuto and ThreeWay and A[p] for Hoare, so
+// already-sorted input is still a worst case; RandomizedQuicksortWith
+// avoids that.
+//
+// The sort is not stable.
+//
+// Time complexity (average): O(n log n)
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack on average
+func QuicksortWith[T cmp.Ordered](A []T, p, r int, opts QuicksortOptions) {
+	QuicksortWithFunc(A, p, r, opts, cmp.Compare[T])
+}
+
+// QuicksortWithFunc is QuicksortWith with elements ordered by the cmp
+// function.
+func QuicksortWithFunc[T any](A []T, p, r int, opts QuicksortOptions, cmp func(a, b T) int) {
+	quicksortScheme(A, p, r, opts.Scheme, nil, cmp)
+}
+
+// RandomizedQuicksortWith sorts A[p..r] in ascending order using
+// randomized quicksort with the partitioning scheme and the source of
+// random pivots selected by opts.
+//
+// The sort is not stable.
+//
+// Time complexity (expected): O(n log n); O(n log k) with ThreeWay for
+// k distinct keys
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack on average
+func RandomizedQuicksortWith[T cmp.Ordered](A []T, p, r int, opts QuicksortOptions) {
+	RandomizedQuicksortWithFunc(A, p, r, opts, cmp.Compare[T])
+}
+
+// RandomizedQuicksortWithFunc is RandomizedQuicksortWith with elements
+// ordered by the cmp function.
+func RandomizedQuicksortWithFunc[T any](A []T, p, r int, opts QuicksortOptions, cmp func(a, b T) int) {
+	quicksortScheme(A, p, r, opts.Scheme, newPivotSource(opts), cmp)
+}
+
+// quicksortScheme is quicksort on A[p..r] with the given scheme. If
+// pivots is not nil, the pivot it chooses is first swapped into the
+// position that the scheme takes its pivot from.
+func quicksortScheme[T any](A []T, p, r int, scheme PartitionScheme, pivots *pivotSource, cmp func(a, b T) int) {
+	if p >= r {
+		return
+	}
+
+	switch scheme {
+	case Hoare:
+		if pivots != nil {
+			i := pivots.choose(p, r)
+			A[p], A[i] = A[i], A[p]
+		}
+		q := HoarePartitionFunc(A, p, r, cmp)
+		quicksortScheme(A, p, q, scheme, pivots, cmp)
+		quicksortScheme(A, q+1, r, scheme, pivots, cmp)
+
+	case ThreeWay:
+		if pivots != nil {
+			i := pivots.choose(p, r)
+			A[r], A[i] = A[i], A[r]
+		}
+		q, t := ThreeWayPartitionFunc(A, p, r, cmp)
+		quicksortScheme(A, p, q-1, scheme, pivots, cmp)
+		quicksortScheme(A, t+1, r, scheme, pivots, cmp)
+
+	default:
+		if pivots != nil {
+			i := pivots.choose(p, r)
+			A[r], A[i] = A[i], A[r]
+		}
+		q := PartitionFunc(A, p, r, cmp)
+		quicksortScheme(A, p, q-1, scheme, pivots, cmp)
+		quicksortScheme(A, q+1, r, scheme, pivots, cmp)
+	}
+}
+
+// HoarePartition partitions A[p..r] around the pivot x = A[p] using
+// the original scheme of C. A. R. Hoare (CLRS Problem 7-1).
+//
+// Two indices move towards each other, i from the left past elements
+// less than x and j from the right past elements greater than x; when
+// both stop, the elements are exchanged. It returns j once the indices
+// cross, and then every element of A[p..j] is <= x and every element of
+// A[j+1..r] is >= x, with p <= j < r. Unlike Partition, the pivot is not
+// necessarily at A[j], so quicksort recurses on A[p..j] and A[j+1..r].
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func HoarePartition[T cmp.Ordered](A []T, p, r int) int {
+	return HoarePartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// HoarePartitionFunc is HoarePartition with elements ordered by the cmp
+// function.
+func HoarePartitionFunc[T any](A []T, p, r int, cmp func(a, b T) int) int {
+	x := A[p]
+	i := p - 1
+	j := r + 1
+
+	for {
+		// Both scans stop at elements equal to x, which keeps them from
+		// running off the subarray and splits runs of equal keys evenly.
+		j--
+		for cmp(A[j], x) > 0 {
+			j--
+		}
+		i++
+		for cmp(A[i], x) < 0 {
+			i++
+		}
+
+		if i >= j {
+			return j
+		}
+		A[i], A[j] = A[j], A[i]
+	}
+}
+
+// ThreeWayPartition partitions A[p..r] around the pivot x = A[r] into
+// three parts, as PARTITION' in CLRS Problem 7-2, using Dijkstra's
+// Dutch national flag algorithm.
+//
+// It returns q and t such that every element of A[p..q-1] is less than
+// x, every element of A[q..t] is equal to x and every element of
+// A[t+1..r] is greater than x.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func ThreeWayPartition[T cmp.Ordered](A []T, p, r int) (q, t int) {
+	return ThreeWayPartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// ThreeWayPartitionFunc is ThreeWayPartition with elements ordered by
+// the cmp function.
+func ThreeWayPartitionFunc[T any](A []T, p, r int, cmp func(a, b T) int) (q, t int) {
+	x := A[r]
+
+	// A[p..lt-1] < x, A[lt..i-1] == x, A[i..gt] unknown, A[gt+1..r] > x.
+	lt, i, gt := p, p, r
+	for i <= gt {
+		switch c := cmp(A[i], x); {
+		case c < 0:
+			A[lt], A[i] = A[i], A[lt]
+			lt++
+			i++
+		case c > 0:
+			A[i], A[gt] = A[gt], A[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
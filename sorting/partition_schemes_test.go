@@ -0,0 +1,119 @@
+package sorting
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestHoarePartition(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(100)) {
+		if len(in) < 2 {
+			continue
+		}
+		A := slices.Clone(in)
+		x := A[0]
+		j := HoarePartition(A, 0, len(A)-1)
+		if j < 0 || j >= len(A)-1 {
+			t.Fatalf("HoarePartition(%v) = %d, want an index in [0, %d)", in, j, len(A)-1)
+		}
+		for k, v := range A {
+			if (k <= j && v > x) || (k > j && v < x) {
+				t.Errorf("HoarePartition(%v) = %d: A[%d] = %d is on the wrong side of %d in %v", in, j, k, v, x, A)
+				break
+			}
+		}
+	}
+}
+
+func TestThreeWayPartition(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(100)) {
+		if len(in) == 0 {
+			continue
+		}
+		A := slices.Clone(in)
+		x := A[len(A)-1]
+		q, r := ThreeWayPartition(A, 0, len(A)-1)
+		if q > r {
+			t.Fatalf("ThreeWayPartition(%v) = %d, %d: the pivot is missing", in, q, r)
+		}
+		for k, v := range A {
+			if (k < q && v >= x) || (k >= q && k <= r && v != x) || (k > r && v <= x) {
+				t.Errorf("ThreeWayPartition(%v) = %d, %d: A[%d] = %d is in the wrong part of %v", in, q, r, k, v, A)
+				break
+			}
+		}
+	}
+}
+
+func TestQuicksortSchemesDuplicates(t *testing.T) {
+	// Lomuto needs n(n-1)/2 comparisons on equal keys; Hoare and
+	// three-way partitioning must stay within O(n log n).
+	const n = 1 << 12
+	lg := bits.Len(n)
+	inputs := map[string][]int{
+		"all-equal":  genInts(n, func(int) int { return 7 }),
+		"few-unique": genInts(n, func(i int) int { return i * 7 % 3 }),
+	}
+	for name, in := range inputs {
+		for _, scheme := range []PartitionScheme{Hoare, ThreeWay} {
+			for _, randomized := range []bool{false, true} {
+				A := slices.Clone(in)
+				comparisons := 0
+				count := func(a, b int) int {
+					comparisons++
+					return cmpInt(a, b)
+				}
+				if randomized {
+					RandomizedQuicksortWithFunc(A, 0, n-1, QuicksortOptions{Scheme: scheme}, count)
+				} else {
+					QuicksortWithFunc(A, 0, n-1, QuicksortOptions{Scheme: scheme}, count)
+				}
+
+				if !slices.IsSorted(A) {
+					t.Fatalf("%v (randomized %v) did not sort %s input", scheme, randomized, name)
+				}
+				if limit := 2 * n * lg; comparisons > limit {
+					t.Errorf("%v (randomized %v) made %d comparisons on %s input, want at most %d",
+						scheme, randomized, comparisons, name, limit)
+				}
+			}
+		}
+	}
+}
+
+func TestPartitionSchemeString(t *testing.T) {
+	for s, want := range map[PartitionScheme]string{Lomuto: "lomuto", Hoare: "hoare", ThreeWay: "three-way", 7: "PartitionScheme(7)"} {
+		if got := s.String(); got != want {
+			t.Errorf("PartitionScheme(%d).String() = %q, want %q", int(s), got, want)
+		}
+	}
+}
+
+func benchmarkQuicksortScheme(b *testing.B, in []int, scheme PartitionScheme) {
+	A := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		RandomizedQuicksortWith(A, 0, len(A)-1, QuicksortOptions{Scheme: scheme})
+	}
+}
+
+func BenchmarkQuicksortLomuto(b *testing.B) {
+	benchmarkQuicksortScheme(b, randomInts(benchSize), Lomuto)
+}
+
+func BenchmarkQuicksortHoare(b *testing.B) {
+	benchmarkQuicksortScheme(b, randomInts(benchSize), Hoare)
+}
+
+func BenchmarkQuicksortThreeWay(b *testing.B) {
+	benchmarkQuicksortScheme(b, randomInts(benchSize), ThreeWay)
+}
+
+func BenchmarkQuicksortHoareFewUnique(b *testing.B) {
+	benchmarkQuicksortScheme(b, genInts(benchSize, func(i int) int { return i % 4 }), Hoare)
+}
+
+func BenchmarkQuicksortThreeWayFewUnique(b *testing.B) {
+	benchmarkQuicksortScheme(b, genInts(benchSize, func(i int) int { return i % 4 }), ThreeWay)
+}
@@ -0,0 +1,111 @@
+package sorting
+
+// probe is the internal hook through which a sort reports the work it
+// does, so that its instrumented and traced versions run the very same
+// code as the plain one. The instrumented versions count comparisons
+// through the cmp callback (see countCalls); compare only tells a trace
+// which elements are being compared.
+//
+// A nil *probe ignores every report. The plain sorts pass nil and pay
+// only a nil check per step.
+type probe[T any] struct {
+	stats      *SortStats   // counters to update, if not nil
+	sink       TraceSink[T] // receiver of trace events, if not nil
+	inversions *int         // inversion count to update, if not nil
+}
+
+// emit sends e to the trace sink, if there is one.
+func (pr *probe[T]) emit(e Event[T]) {
+	if pr != nil && pr.sink != nil {
+		pr.sink.Emit(e)
+	}
+}
+
+// enter reports a call at recursion depth depth, the top-level call
+// being 1.
+func (pr *probe[T]) enter(depth int) {
+	if pr != nil && pr.stats != nil {
+		pr.stats.MaxDepth = max(pr.stats.MaxDepth, depth)
+	}
+}
+
+// compare reports that A[i] is about to be compared with A[j].
+func (pr *probe[T]) compare(i, j int) {
+	pr.emit(Event[T]{Kind: EventCompare, I: i, J: j})
+}
+
+// swap reports that A[i] and A[j] were exchanged.
+func (pr *probe[T]) swap(i, j int) {
+	if pr != nil && pr.stats != nil {
+		pr.stats.Swaps++
+	}
+	pr.emit(Event[T]{Kind: EventSwap, I: i, J: j})
+}
+
+// write reports that v was stored in A[i].
+func (pr *probe[T]) write(i int, v T) {
+	if pr != nil && pr.stats != nil {
+		pr.stats.Moves++
+	}
+	pr.emit(Event[T]{Kind: EventWrite, I: i, Value: v})
+}
+
+// invert reports that a merge took an element from its right half ahead
+// of the k elements still waiting in its left half, which makes k
+// inversions.
+func (pr *probe[T]) invert(k int) {
+	if pr != nil && pr.inversions != nil {
+		*pr.inversions += k
+	}
+}
+
+// partition reports that A[p..r] is about to be partitioned.
+func (pr *probe[T]) partition(p, r int) {
+	pr.emit(Event[T]{Kind: EventPartition, Lo: p, Hi: r})
+}
+
+// merge reports that A[lo:mid] and A[mid:hi] are about to be merged.
+func (pr *probe[T]) merge(lo, mid, hi int) {
+	pr.emit(Event[T]{Kind: EventMerge, Lo: lo, Mid: mid, Hi: hi})
+}
+
+// append returns append(result, xs...), reporting one write per element
+// and an allocation if the backing array of result has to grow. result
+// is the output of a merge whose first element belongs at index lo.
+func (pr *probe[T]) append(result []T, lo int, xs ...T) []T {
+	if pr != nil {
+		if pr.stats != nil && len(result)+len(xs) > cap(result) {
+			pr.stats.Allocations++
+		}
+		for k, x := range xs {
+			pr.write(lo+len(result)+k, x)
+		}
+	}
+	return append(result, xs...)
+}
+
+// heapObserver adapts a probe to heap.Observer, for the sorts built on
+// heap.Heap.
+type heapObserver[T any] struct {
+	pr *probe[T]
+}
+
+// Heapify reports the start of a heapify of node i of A[:n] to the
+// probe's trace.
+func (o heapObserver[T]) Heapify(i, n int) {
+	o.pr.emit(Event[T]{Kind: EventHeapify, I: i, Hi: n})
+}
+
+// Compare reports the comparison of A[i] with A[j] to the probe.
+func (o heapObserver[T]) Compare(i, j int) { o.pr.compare(i, j) }
+
+// Swap reports the exchange of A[i] and A[j] to the probe.
+func (o heapObserver[T]) Swap(i, j int) { o.pr.swap(i, j) }
+
+// countCalls returns cmp with every call counted in *n.
+func countCalls[T any](cmp func(a, b T) int, n *int) func(a, b T) int {
+	return func(a, b T) int {
+		*n++
+		return cmp(a, b)
+	}
+}
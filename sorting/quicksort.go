@@ -0,0 +1,84 @@
+package sorting
+
+import "cmp"
+
+// Quicksort sorts the given slice in ascending order
+// using the quicksort algorithm.
+//
+// This algorithm follows the divide-and-conquer approach:
+//  1. Partition the slice around a pivot so that elements <= pivot
+//     go to the left side and elements > pivot go to the right.
+//  2. Recursively sort the left and right sub-slices.
+//
+// The sort is not stable: Partition swaps elements over long distances.
+//
+// Time complexity (average): O(n log n)
+// Time complexity (worst case): O(n²)     // occurs when the pivot is poor
+// Space complexity: O(log n) recursion stack
+func Quicksort[T cmp.Ordered](A []T, p, r int) {
+	QuicksortFunc(A, p, r, cmp.Compare[T])
+}
+
+// QuicksortFunc sorts A[p..r] in ascending order as determined by the
+// cmp function, using the quicksort algorithm.
+//
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b, as in slices.SortFunc.
+//
+// The sort is not stable.
+func QuicksortFunc[T any](A []T, p, r int, cmp func(a, b T) int) {
+	quicksort(A, p, r, 1, cmp, nil)
+}
+
+// quicksort is QuicksortFunc at recursion depth depth, reporting its
+// work to pr.
+func quicksort[T any](A []T, p, r, depth int, cmp func(a, b T) int, pr *probe[T]) {
+	pr.enter(depth)
+	if p < r {
+		q := partition(A, p, r, cmp, pr)
+		quicksort(A, p, q-1, depth+1, cmp, pr)
+		quicksort(A, q+1, r, depth+1, cmp, pr)
+	}
+}
+
+// Partition rearranges the elements in A[p..r] around a pivot,
+// which is chosen as A[r] in this implementation (Lomuto scheme).
+//
+// All elements <= pivot are moved to the left side,
+// and all elements > pivot are moved to the right side.
+//
+// It returns the final index of the pivot after partitioning,
+// which is used by Quicksort to split the array.
+//
+// Time complexity: O(n)
+// Space complexity: O(1)
+func Partition[T cmp.Ordered](A []T, p, r int) int {
+	return PartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// PartitionFunc is Partition with elements ordered by the cmp function.
+func PartitionFunc[T any](A []T, p, r int, cmp func(a, b T) int) int {
+	return partition(A, p, r, cmp, nil)
+}
+
+// partition is PartitionFunc reporting its work to pr.
+func partition[T any](A []T, p, r int, cmp func(a, b T) int, pr *probe[T]) int {
+	pr.partition(p, r)
+	x := A[r]  // pivot element
+	i := p - 1 // boundary of the <= pivot side
+
+	for j := p; j < r; j++ {
+		// Move elements <= pivot to the left side
+		pr.compare(j, r)
+		if cmp(A[j], x) <= 0 {
+			i++
+			A[i], A[j] = A[j], A[i]
+			pr.swap(i, j)
+		}
+	}
+
+	// Place the pivot in its correct sorted position
+	A[i+1], A[r] = A[r], A[i+1]
+	pr.swap(i+1, r)
+	return i + 1
+}
@@ -0,0 +1,25 @@
+package sorting
+
+import "cmp"
+
+// QuicksortWithStats sorts A[p..r] exactly like Quicksort and reports
+// the work it did.
+//
+// On already-sorted input every partition is maximally unbalanced, so
+// it makes n(n-1)/2 comparisons and MaxDepth reaches n.
+func QuicksortWithStats[T cmp.Ordered](A []T, p, r int) SortStats {
+	var s SortStats
+	quicksort(A, p, r, 1, countCalls(cmp.Compare[T], &s.Comparisons), &probe[T]{stats: &s})
+	return s
+}
+
+// RandomizedQuicksortWithStats sorts A[p..r] exactly like
+// RandomizedQuicksort and reports the work it did.
+//
+// The expected number of comparisons is about 1.39 n lg n and the
+// expected MaxDepth is O(log n), whatever the input order.
+func RandomizedQuicksortWithStats[T cmp.Ordered](A []T, p, r int) SortStats {
+	var s SortStats
+	randomizedQuicksort(A, p, r, 1, countCalls(cmp.Compare[T], &s.Comparisons), &probe[T]{stats: &s})
+	return s
+}
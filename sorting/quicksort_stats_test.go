@@ -0,0 +1,33 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestQuicksortWithStats(t *testing.T) {
+	const n = 500
+	A := make([]int, n)
+	for i := range A {
+		A[i] = i
+	}
+
+	// Sorted input is Quicksort's worst case.
+	s := QuicksortWithStats(A, 0, n-1)
+	if !slices.IsSorted(A) {
+		t.Fatal("QuicksortWithStats did not sort")
+	}
+	if s.Comparisons != n*(n-1)/2 || s.MaxDepth != n {
+		t.Errorf("QuicksortWithStats on sorted input = %+v, want %d comparisons and depth %d",
+			s, n*(n-1)/2, n)
+	}
+
+	// The random pivot keeps it close to 2n ln n on the same input.
+	s = RandomizedQuicksortWithStats(A, 0, n-1)
+	if !slices.IsSorted(A) {
+		t.Fatal("RandomizedQuicksortWithStats did not sort")
+	}
+	if s.Comparisons > n*n/8 || s.MaxDepth > n/4 {
+		t.Errorf("RandomizedQuicksortWithStats on sorted input = %+v", s)
+	}
+}
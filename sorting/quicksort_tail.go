@@ -0,0 +1,116 @@
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+
+	"github.com/MohammadTaghipour/Algorithms-CLRS/lists"
+)
+
+// TailRecursiveQuicksort sorts A[p..r] in ascending order using the
+// TRE-QUICKSORT algorithm of CLRS Problem 7-4, modified to recurse on
+// the smaller side.
+//
+// After partitioning, only the smaller of the two sides is sorted by a
+// recursive call; the larger one is sorted by the next iteration of a
+// loop, the tail call having been eliminated. Every recursive call is
+// then on at most half of its caller's subarray, so the recursion is at
+// most ⌊lg n⌋+1 deep even when the partitions are maximally unbalanced,
+// as on already-sorted input.
+//
+// The sort is not stable.
+//
+// Time complexity (average): O(n log n)
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n) recursion stack in the worst case
+func TailRecursiveQuicksort[T cmp.Ordered](A []T, p, r int) {
+	TailRecursiveQuicksortFunc(A, p, r, cmp.Compare[T])
+}
+
+// TailRecursiveQuicksortFunc is TailRecursiveQuicksort with elements
+// ordered by the cmp function.
+func TailRecursiveQuicksortFunc[T any](A []T, p, r int, cmp func(a, b T) int) {
+	treQuicksort(A, p, r, 1, cmp)
+}
+
+// treQuicksort sorts A[p..r] at recursion depth depth and returns the
+// deepest level reached, for the tests.
+func treQuicksort[T any](A []T, p, r, depth int, cmp func(a, b T) int) int {
+	maxDepth := depth
+	for p < r {
+		q := PartitionFunc(A, p, r, cmp)
+		if q-p < r-q {
+			maxDepth = max(maxDepth, treQuicksort(A, p, q-1, depth+1, cmp))
+			p = q + 1
+		} else {
+			maxDepth = max(maxDepth, treQuicksort(A, q+1, r, depth+1, cmp))
+			r = q - 1
+		}
+	}
+	return maxDepth
+}
+
+// IterativeQuicksort sorts A[p..r] in ascending order using quicksort
+// without any recursion.
+//
+// The subarrays still to be sorted are kept on a lists.Stack. After
+// partitioning, the larger side is pushed and the smaller one is sorted
+// next. As in TailRecursiveQuicksort, the range being sorted at least
+// halves with every push, so the stack never holds more than ⌊lg n⌋+1
+// ranges. Its capacity is fixed at that bound up front.
+//
+// The sort is not stable.
+//
+// Time complexity (average): O(n log n)
+// Time complexity (worst case): O(n²)
+// Space complexity: O(log n)
+func IterativeQuicksort[T cmp.Ordered](A []T, p, r int) {
+	IterativeQuicksortFunc(A, p, r, cmp.Compare[T])
+}
+
+// IterativeQuicksortFunc is IterativeQuicksort with elements ordered by
+// the cmp function.
+func IterativeQuicksortFunc[T any](A []T, p, r int, cmp func(a, b T) int) {
+	iterativeQuicksort(A, p, r, cmp)
+}
+
+// subarray is a range A[p..r] waiting on IterativeQuicksort's stack.
+type subarray struct {
+	p, r int
+}
+
+// iterativeQuicksort sorts A[p..r] and returns the largest number of
+// ranges that were on the stack at once, for the tests.
+func iterativeQuicksort[T any](A []T, p, r int, cmp func(a, b T) int) int {
+	if p >= r {
+		return 0
+	}
+
+	stack := lists.NewStack[subarray](bits.Len(uint(r - p + 1)))
+	push := func(s subarray) {
+		if err := stack.Push(s); err != nil {
+			panic("iterative quicksort: " + err.Error())
+		}
+	}
+
+	maxLength := 0
+	push(subarray{p, r})
+	for !stack.IsEmpty() {
+		maxLength = max(maxLength, stack.Length())
+		s, _ := stack.Pop()
+
+		p, r := s.p, s.r
+		for p < r {
+			q := PartitionFunc(A, p, r, cmp)
+			if q-p < r-q {
+				push(subarray{q + 1, r})
+				r = q - 1
+			} else {
+				push(subarray{p, q - 1})
+				p = q + 1
+			}
+			maxLength = max(maxLength, stack.Length())
+		}
+	}
+	return maxLength
+}
@@ -0,0 +1,61 @@
+package sorting
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestTailRecursiveQuicksort(t *testing.T) {
+	for _, in := range append(slices.Clone(sortInputs), randomInts(1000)) {
+		got := slices.Clone(in)
+		TailRecursiveQuicksort(got, 0, len(got)-1)
+		got2 := slices.Clone(in)
+		IterativeQuicksort(got2, 0, len(got2)-1)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("TailRecursiveQuicksort(%v) = %v, want %v", in, got, want)
+		}
+		if !slices.Equal(got2, want) {
+			t.Errorf("IterativeQuicksort(%v) = %v, want %v", in, got2, want)
+		}
+	}
+}
+
+func TestQuicksortStackDepth(t *testing.T) {
+	// Sorted, reversed and all-equal inputs make Quicksort recurse n
+	// levels deep; both variants must stay within ⌊lg n⌋+1.
+	const n = 1 << 12
+	limit := bits.Len(n)
+	inputs := map[string][]int{
+		"sorted":    genInts(n, func(i int) int { return i }),
+		"reversed":  genInts(n, func(i int) int { return n - i }),
+		"all-equal": genInts(n, func(int) int { return 7 }),
+		"random":    randomInts(n),
+	}
+	for name, in := range inputs {
+		A := slices.Clone(in)
+		if depth := treQuicksort(A, 0, n-1, 1, cmpInt); depth > limit {
+			t.Errorf("TailRecursiveQuicksort on %s input recursed %d deep, want at most %d", name, depth, limit)
+		}
+		if !slices.IsSorted(A) {
+			t.Errorf("TailRecursiveQuicksort did not sort %s input", name)
+		}
+
+		A = slices.Clone(in)
+		if length := iterativeQuicksort(A, 0, n-1, cmpInt); length > limit {
+			t.Errorf("IterativeQuicksort on %s input held %d ranges on its stack, want at most %d", name, length, limit)
+		}
+		if !slices.IsSorted(A) {
+			t.Errorf("IterativeQuicksort did not sort %s input", name)
+		}
+	}
+
+	// For contrast: the same sorted input takes Quicksort n levels deep.
+	s := QuicksortWithStats(genInts(1000, func(i int) int { return i }), 0, 999)
+	if s.MaxDepth != 1000 {
+		t.Errorf("Quicksort on sorted input recursed %d deep, want 1000", s.MaxDepth)
+	}
+}
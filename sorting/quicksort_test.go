@@ -0,0 +1,41 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestQuicksort(t *testing.T) {
+	for _, in := range sortInputs {
+		got := slices.Clone(in)
+		Quicksort(got, 0, len(got)-1)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("Quicksort(%v) = %v, want %v", in, got, want)
+		}
+	}
+
+	words := []string{"pear", "apple", "fig", "apple"}
+	Quicksort(words, 0, len(words)-1)
+	if !slices.IsSorted(words) {
+		t.Errorf("Quicksort on strings = %v, not sorted", words)
+	}
+}
+
+func TestQuicksortFuncNotStable(t *testing.T) {
+	type record struct{ key, id int }
+	byKey := func(a, b record) int { return cmp.Compare(a.key, b.key) }
+
+	// The smaller pivot is swapped to the front, past both equal keys.
+	R := []record{{1, 0}, {1, 1}, {0, 2}}
+	QuicksortFunc(R, 0, len(R)-1, byKey)
+	if !slices.IsSortedFunc(R, byKey) {
+		t.Fatalf("QuicksortFunc(%v) is not sorted", R)
+	}
+	if R[1].id != 1 || R[2].id != 0 {
+		t.Errorf("QuicksortFunc(%v): expected equal keys to be reordered", R)
+	}
+}
@@ -0,0 +1,20 @@
+package sorting
+
+import "cmp"
+
+// QuicksortTraced sorts A[p..r] exactly like Quicksort, reporting every
+// step to sink.
+//
+// Each call of Partition is announced by a partition event with its
+// bounds, followed by a compare(j, r) against the pivot for every j and
+// the swaps that move elements across the boundary.
+func QuicksortTraced[T cmp.Ordered](A []T, p, r int, sink TraceSink[T]) {
+	quicksort(A, p, r, 1, cmp.Compare[T], &probe[T]{sink: sink})
+}
+
+// RandomizedQuicksortTraced sorts A[p..r] exactly like
+// RandomizedQuicksort, reporting every step to sink. Moving the random
+// pivot to A[r] is reported as a swap before the partition event.
+func RandomizedQuicksortTraced[T cmp.Ordered](A []T, p, r int, sink TraceSink[T]) {
+	randomizedQuicksort(A, p, r, 1, cmp.Compare[T], &probe[T]{sink: sink})
+}
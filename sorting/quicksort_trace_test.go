@@ -0,0 +1,45 @@
+package sorting
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestQuicksortTracedReplay(t *testing.T) {
+	sorts := map[string]func([]int, int, int, TraceSink[int]){
+		"QuicksortTraced":           QuicksortTraced[int],
+		"RandomizedQuicksortTraced": RandomizedQuicksortTraced[int],
+	}
+	for name, sort := range sorts {
+		for _, in := range sortInputs {
+			A := slices.Clone(in)
+			var rec TraceRecorder[int]
+			sort(A, 0, len(A)-1, &rec)
+
+			if !slices.IsSorted(A) {
+				t.Fatalf("%s(%v) = %v, not sorted", name, in, A)
+			}
+			if got := Replay(in, rec.Events, nil); !slices.Equal(got, A) {
+				t.Errorf("%s(%v): replay ends in %v, want %v", name, in, got, A)
+			}
+		}
+	}
+}
+
+func TestQuicksortTracedJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONLinesSink[int](&buf)
+	QuicksortTraced([]int{2, 1}, 0, 1, sink)
+	if err := sink.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"op":"partition","lo":0,"hi":1}
+{"op":"compare","i":0,"j":1}
+{"op":"swap","i":0,"j":1}
+`
+	if buf.String() != want {
+		t.Errorf("trace =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
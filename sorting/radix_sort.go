@@ -0,0 +1,138 @@
+package sorting
+
+import "math/bits"
+
+// RadixSortOptions configures the radix sorts.
+type RadixSortOptions struct {
+	// DigitBits is the width of one digit in bits, so that every pass
+	// sorts on 2^DigitBits possible digit values. Zero or less selects a
+	// default of 8; values above 16 are reduced to 16.
+	//
+	// RadixSortStrings works on whole bytes and uses a digit of one byte
+	// for DigitBits up to 8 and of two bytes above that.
+	DigitBits int
+}
+
+// digitBits returns the digit width selected by opts.
+func (opts RadixSortOptions) digitBits() int {
+	if opts.DigitBits <= 0 {
+		return 8
+	}
+	return min(opts.DigitBits, 16)
+}
+
+// RadixSortUint64 sorts the given slice in ascending order using the
+// least-significant-digit RADIX-SORT algorithm from CLRS.
+//
+// The keys are cut into digits of opts.DigitBits bits, and the slice is
+// sorted on each digit in turn, from the least significant one, with a
+// stable counting sort. Passes above the highest bit set in any key are
+// skipped, since every key has a zero digit there.
+//
+// Time complexity: Θ((b/r)(n + 2^r)) for b-bit keys and r-bit digits
+// Space complexity: Θ(n + 2^r)
+func RadixSortUint64(A []uint64, opts RadixSortOptions) {
+	r := opts.digitBits()
+	var all uint64
+	for _, x := range A {
+		all |= x
+	}
+	mask := uint64(1)<<r - 1
+	passes := (bits.Len64(all) + r - 1) / r
+	lsdRadixSort(A, passes, 1<<r, func(x uint64, pass int) int {
+		return int(x >> (pass * r) & mask)
+	})
+}
+
+// RadixSortInt64 is RadixSortUint64 for signed keys.
+//
+// Flipping the sign bit of a two's complement integer maps the signed
+// order onto the unsigned one, so negative keys need no extra pass.
+//
+// Time complexity: Θ((64/r)(n + 2^r)) for r-bit digits
+// Space complexity: Θ(n + 2^r)
+func RadixSortInt64(A []int64, opts RadixSortOptions) {
+	const signBit = 1 << 63
+	r := opts.digitBits()
+	var all uint64
+	for _, x := range A {
+		all |= uint64(x) ^ signBit
+	}
+	mask := uint64(1)<<r - 1
+	passes := (bits.Len64(all) + r - 1) / r
+	lsdRadixSort(A, passes, 1<<r, func(x int64, pass int) int {
+		return int((uint64(x) ^ signBit) >> (pass * r) & mask)
+	})
+}
+
+// RadixSortStrings sorts the given slice of strings in ascending
+// (byte-wise lexicographic) order using an LSD radix sort.
+//
+// Every string is treated as padded on the right to the length of the
+// longest one, with a padding symbol that sorts before every byte, so
+// that a string sorts before its extensions. Each digit is one or two
+// bytes, as selected by opts.DigitBits; a byte b is the digit value b+1
+// and padding is 0.
+//
+// Time complexity: Θ((m/w)(n + 257^w)) for strings of at most m bytes
+// and digits of w bytes
+// Space complexity: Θ(n + 257^w)
+func RadixSortStrings(A []string, opts RadixSortOptions) {
+	w := (opts.digitBits() + 7) / 8
+	m := 0
+	for _, s := range A {
+		m = max(m, len(s))
+	}
+	passes := (m + w - 1) / w
+
+	buckets := 1
+	for i := 0; i < w; i++ {
+		buckets *= 257
+	}
+	lsdRadixSort(A, passes, buckets, func(s string, pass int) int {
+		// Pass 0 sorts on the last digit of the padded strings.
+		start := (passes - 1 - pass) * w
+		d := 0
+		for i := start; i < start+w; i++ {
+			d *= 257
+			if i < len(s) {
+				d += int(s[i]) + 1
+			}
+		}
+		return d
+	})
+}
+
+// lsdRadixSort sorts A by passes stable counting sorts, the i-th one on
+// digit(x, i), which must lie in [0, buckets). Pass 0 sorts on the least
+// significant digit.
+//
+// The passes alternate between A and one buffer of the same length, and
+// the result is copied back into A if it ends up in the buffer.
+func lsdRadixSort[T any](A []T, passes, buckets int, digit func(x T, pass int) int) {
+	if len(A) < 2 || passes == 0 {
+		return
+	}
+
+	src, dst := A, make([]T, len(A))
+	C := make([]int, buckets)
+	for pass := 0; pass < passes; pass++ {
+		clear(C)
+		for _, x := range src {
+			C[digit(x, pass)]++
+		}
+		for i := 1; i < buckets; i++ {
+			C[i] += C[i-1]
+		}
+		for j := len(src) - 1; j >= 0; j-- {
+			d := digit(src[j], pass)
+			C[d]--
+			dst[C[d]] = src[j]
+		}
+		src, dst = dst, src
+	}
+
+	if passes%2 == 1 {
+		copy(A, src)
+	}
+}
@@ -0,0 +1,77 @@
+package sorting
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRadixSortUint64(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	in := make([]uint64, 1000)
+	for i := range in {
+		in[i] = rng.Uint64() >> rng.Intn(64)
+	}
+	in = append(in, 0, math.MaxUint64, 1<<63)
+
+	want := slices.Clone(in)
+	slices.Sort(want)
+	for _, bits := range []int{0, 1, 7, 8, 11, 16, 64} {
+		got := slices.Clone(in)
+		RadixSortUint64(got, RadixSortOptions{DigitBits: bits})
+		if !slices.Equal(got, want) {
+			t.Errorf("RadixSortUint64 with %d-bit digits did not sort", bits)
+		}
+	}
+}
+
+func TestRadixSortInt64(t *testing.T) {
+	in := []int64{5, -3, 0, math.MinInt64, math.MaxInt64, -1, 1, -3, 42, math.MinInt64 + 1}
+	want := slices.Clone(in)
+	slices.Sort(want)
+	for _, bits := range []int{3, 8, 16} {
+		got := slices.Clone(in)
+		RadixSortInt64(got, RadixSortOptions{DigitBits: bits})
+		if !slices.Equal(got, want) {
+			t.Errorf("RadixSortInt64 with %d-bit digits = %v, want %v", bits, got, want)
+		}
+	}
+}
+
+func TestRadixSortStrings(t *testing.T) {
+	in := []string{
+		"pear", "apple", "", "fig", "apple", "app", "b", "a\x00", "a",
+		"\xff", "\x00", "banana", "band", "ban", "zz", "z",
+	}
+	want := slices.Clone(in)
+	slices.Sort(want)
+	for _, bits := range []int{8, 9, 16} {
+		got := slices.Clone(in)
+		RadixSortStrings(got, RadixSortOptions{DigitBits: bits})
+		if !slices.Equal(got, want) {
+			t.Errorf("RadixSortStrings with %d-bit digits = %q, want %q", bits, got, want)
+		}
+	}
+}
+
+func benchmarkRadixSort(b *testing.B, opts RadixSortOptions) {
+	in := randomInts(benchSize)
+	A := make([]int64, len(in))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range in {
+			A[j] = int64(x)
+		}
+		RadixSortInt64(A, opts)
+	}
+}
+
+func BenchmarkRadixSort8(b *testing.B) {
+	benchmarkRadixSort(b, RadixSortOptions{DigitBits: 8})
+}
+
+func BenchmarkRadixSort16(b *testing.B) {
+	benchmarkRadixSort(b, RadixSortOptions{DigitBits: 16})
+}
@@ -0,0 +1,119 @@
+package sorting
+
+import (
+	"cmp"
+	"math/rand"
+)
+
+// RandomizedQuicksort sorts the given slice in ascending order
+// using the randomized quicksort algorithm.
+//
+// This algorithm follows the divide-and-conquer approach with a randomly chosen pivot:
+//  1. Randomly select a pivot from the sub-slice A[p..r].
+//  2. Partition the slice around the pivot so that elements <= pivot
+//     go to the left side and elements > pivot go to the right.
+//  3. Recursively sort the left and right sub-slices.
+//
+// The sort is not stable. Pivots come from the global source of
+// math/rand; RandomizedQuicksortWith can seed them or replay them.
+//
+// Time complexity (average): O(n log n)
+// Time complexity (worst case): O(n²)     // highly unlikely due to random pivot
+// Space complexity: O(log n) recursion stack
+func RandomizedQuicksort[T cmp.Ordered](A []T, p, r int) {
+	RandomizedQuicksortFunc(A, p, r, cmp.Compare[T])
+}
+
+// RandomizedQuicksortFunc sorts A[p..r] in ascending order as determined
+// by the cmp function, using the randomized quicksort algorithm.
+//
+// The sort is not stable.
+func RandomizedQuicksortFunc[T any](A []T, p, r int, cmp func(a, b T) int) {
+	randomizedQuicksort(A, p, r, 1, cmp, nil)
+}
+
+// randomizedQuicksort is RandomizedQuicksortFunc at recursion depth
+// depth, reporting its work to pr.
+func randomizedQuicksort[T any](A []T, p, r, depth int, cmp func(a, b T) int, pr *probe[T]) {
+	pr.enter(depth)
+	if p < r {
+		q := randomizedPartition(A, p, r, cmp, pr)
+		randomizedQuicksort(A, p, q-1, depth+1, cmp, pr)
+		randomizedQuicksort(A, q+1, r, depth+1, cmp, pr)
+	}
+}
+
+// RandomizedPartition selects a random pivot from A[p..r], swaps it with the last element,
+// and then partitions the slice using the standard Lomuto partition scheme.
+//
+// Returns the final index of the pivot after partitioning.
+func RandomizedPartition[T cmp.Ordered](A []T, p, r int) int {
+	return RandomizedPartitionFunc(A, p, r, cmp.Compare[T])
+}
+
+// RandomizedPartitionFunc is RandomizedPartition with elements ordered
+// by the cmp function.
+func RandomizedPartitionFunc[T any](A []T, p, r int, cmp func(a, b T) int) int {
+	return randomizedPartition(A, p, r, cmp, nil)
+}
+
+// randomizedPartition is RandomizedPartitionFunc reporting its work to
+// pr.
+func randomizedPartition[T any](A []T, p, r int, cmp func(a, b T) int, pr *probe[T]) int {
+	// Pick a random index between p and r (inclusive)
+	i := rand.Intn(r-p+1) + p
+
+	// Swap the random pivot with the last element
+	A[r], A[i] = A[i], A[r]
+	pr.swap(r, i)
+
+	// Partition the slice around the pivot
+	return partition(A, p, r, cmp, pr)
+}
+
+// pivotSource chooses the random pivots of one call of a randomized
+// quicksort, as configured by its options.
+type pivotSource struct {
+	rng    *rand.Rand // nil selects the global source
+	record *[]int
+	replay []int
+	next   int // index of the next pivot in replay
+}
+
+// newPivotSource returns the pivot source selected by opts.
+func newPivotSource(opts QuicksortOptions) *pivotSource {
+	ps := &pivotSource{
+		rng:    opts.Rand,
+		record: opts.RecordPivots,
+		replay: opts.ReplayPivots,
+	}
+	if ps.rng == nil && opts.Seeded {
+		ps.rng = rand.New(rand.NewSource(opts.Seed))
+	}
+	return ps
+}
+
+// choose returns the index of the pivot for A[p..r].
+func (ps *pivotSource) choose(p, r int) int {
+	var i int
+	switch {
+	case ps.replay != nil:
+		if ps.next == len(ps.replay) {
+			panic("randomized quicksort: replayed pivots exhausted")
+		}
+		i = ps.replay[ps.next]
+		ps.next++
+		if i < p || i > r {
+			panic("randomized quicksort: replayed pivot outside subarray")
+		}
+	case ps.rng != nil:
+		i = ps.rng.Intn(r-p+1) + p
+	default:
+		i = rand.Intn(r-p+1) + p
+	}
+
+	if ps.record != nil {
+		*ps.record = append(*ps.record, i)
+	}
+	return i
+}
@@ -0,0 +1,116 @@
+package sorting
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestRandomizedQuicksort(t *testing.T) {
+	for _, in := range sortInputs {
+		got := slices.Clone(in)
+		RandomizedQuicksort(got, 0, len(got)-1)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("RandomizedQuicksort(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestRandomizedQuicksortFunc(t *testing.T) {
+	A := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	RandomizedQuicksortFunc(A, 0, len(A)-1, func(a, b int) int { return cmp.Compare(b, a) })
+	if !slices.Equal(A, []int{9, 6, 5, 4, 3, 2, 1, 1}) {
+		t.Errorf("descending RandomizedQuicksortFunc = %v", A)
+	}
+}
+
+func TestRandomizedQuicksortWithSeed(t *testing.T) {
+	in := randomInts(500)
+	for _, scheme := range []PartitionScheme{Lomuto, Hoare, ThreeWay} {
+		// Seed 0 is as reproducible as any other.
+		for _, seed := range []int64{0, 42} {
+			var first, second, other []int
+			A := slices.Clone(in)
+			RandomizedQuicksortWith(A, 0, len(A)-1, QuicksortOptions{Scheme: scheme, Seeded: true, Seed: seed, RecordPivots: &first})
+			if !slices.IsSorted(A) {
+				t.Fatalf("%v: RandomizedQuicksortWith did not sort", scheme)
+			}
+			A = slices.Clone(in)
+			RandomizedQuicksortWith(A, 0, len(A)-1, QuicksortOptions{Scheme: scheme, Seeded: true, Seed: seed, RecordPivots: &second})
+			A = slices.Clone(in)
+			rng := rand.New(rand.NewSource(seed + 1))
+			RandomizedQuicksortWith(A, 0, len(A)-1, QuicksortOptions{Scheme: scheme, Rand: rng, RecordPivots: &other})
+
+			if len(first) == 0 || !slices.Equal(first, second) {
+				t.Errorf("%v: runs with seed %d chose pivots %v and %v", scheme, seed, first, second)
+			}
+			if slices.Equal(first, other) {
+				t.Errorf("%v: runs with seeds %d and %d chose the same pivots", scheme, seed, seed+1)
+			}
+		}
+	}
+}
+
+func TestRandomizedQuicksortWithReplay(t *testing.T) {
+	// Records with many equal keys, so that an unstable sort's output
+	// depends on the pivots and a replay can be told from a new run.
+	in := make([]record, 300)
+	for i := range in {
+		in[i] = record{key: i * 7 % 5, id: i}
+	}
+
+	for _, scheme := range []PartitionScheme{Lomuto, Hoare, ThreeWay} {
+		var recorded, replayed []int
+		want := slices.Clone(in)
+		RandomizedQuicksortWithFunc(want, 0, len(want)-1, QuicksortOptions{Scheme: scheme, RecordPivots: &recorded}, byKey)
+
+		got := slices.Clone(in)
+		opts := QuicksortOptions{Scheme: scheme, ReplayPivots: recorded, RecordPivots: &replayed}
+		RandomizedQuicksortWithFunc(got, 0, len(got)-1, opts, byKey)
+
+		if !slices.Equal(got, want) {
+			t.Errorf("%v: replayed run differs from the recorded one", scheme)
+		}
+		if !slices.Equal(replayed, recorded) {
+			t.Errorf("%v: replayed run chose pivots %v, want %v", scheme, replayed, recorded)
+		}
+	}
+}
+
+func TestRandomizedQuicksortWithBadReplay(t *testing.T) {
+	for name, pivots := range map[string][]int{
+		"exhausted":        {1},
+		"outside subarray": {9},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("replay with %s pivots did not panic", name)
+				}
+			}()
+			A := []int{3, 1, 4, 1, 5}
+			RandomizedQuicksortWith(A, 0, len(A)-1, QuicksortOptions{ReplayPivots: pivots})
+		}()
+	}
+}
+
+func TestRandomizedQuicksortWithConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			A := randomInts(2000)
+			RandomizedQuicksortWith(A, 0, len(A)-1, QuicksortOptions{Rand: rand.New(rand.NewSource(seed))})
+			if !slices.IsSorted(A) {
+				t.Errorf("concurrent sort with seed %d did not sort", seed)
+			}
+		}(int64(g + 1))
+	}
+	wg.Wait()
+}
@@ -0,0 +1,127 @@
+package sorting
+
+import "cmp"
+
+// RandomizedSelect returns the i-th smallest element of A[p..r], for i
+// from 1 to r-p+1, using the RANDOMIZED-SELECT algorithm from CLRS.
+//
+// Like quicksort, it partitions A[p..r] around a random pivot, but then
+// continues only into the side that holds the i-th smallest element.
+//
+// On return the element is at A[p+i-1], no element of A[p..p+i-2] is
+// greater than it, and no element of A[p+i..r] is smaller. Elements
+// equal to the pivot are grouped next to it after every partition, so
+// that many duplicate keys cannot make the search degenerate.
+//
+// It panics if i is out of range.
+//
+// Time complexity (expected): O(n)
+// Time complexity (worst case): O(n²)
+// Space complexity: O(1)
+func RandomizedSelect[T cmp.Ordered](A []T, p, r, i int) T {
+	return RandomizedSelectFunc(A, p, r, i, cmp.Compare[T])
+}
+
+// RandomizedSelectFunc is RandomizedSelect with elements ordered by the
+// cmp function.
+func RandomizedSelectFunc[T any](A []T, p, r, i int, cmp func(a, b T) int) T {
+	t := rankIndex(p, r, i)
+	for p < r {
+		q := RandomizedPartitionFunc(A, p, r, cmp)
+		lo := groupEqual(A, p, q, cmp)
+
+		if t < lo {
+			r = lo - 1
+		} else if t > q {
+			p = q + 1
+		} else {
+			break
+		}
+	}
+	return A[t]
+}
+
+// Select returns the i-th smallest element of A[p..r], for i from 1 to
+// r-p+1, using the worst-case linear SELECT algorithm from CLRS.
+//
+// The elements are divided into groups of 5, the median of each group
+// is found by insertion sort, and Select is applied recursively to find
+// the median x of these medians. Partitioning around x guarantees that
+// at least about 3n/10 elements fall on each side, so the search
+// continues on at most about 7n/10 elements.
+//
+// On return A is rearranged as by RandomizedSelect.
+//
+// It panics if i is out of range.
+//
+// Time complexity: O(n)
+// Space complexity: O(log n) recursion stack
+func Select[T cmp.Ordered](A []T, p, r, i int) T {
+	return SelectFunc(A, p, r, i, cmp.Compare[T])
+}
+
+// SelectFunc is Select with elements ordered by the cmp function.
+func SelectFunc[T any](A []T, p, r, i int, cmp func(a, b T) int) T {
+	t := rankIndex(p, r, i)
+	selectAt(A, p, r, t, cmp)
+	return A[t]
+}
+
+// selectAt rearranges A[p..r] so that A[t] holds the element that would
+// be there if A[p..r] were sorted, with no greater element before it and
+// no smaller element after it.
+func selectAt[T any](A []T, p, r, t int, cmp func(a, b T) int) {
+	for r-p+1 > 5 {
+		// Sort every group of 5 and move its median to the front.
+		g := 0
+		for j := p; j <= r; j += 5 {
+			e := min(j+4, r)
+			InsertionSortFunc(A[j:e+1], cmp)
+			m := j + (e-j)/2
+			A[p+g], A[m] = A[m], A[p+g]
+			g++
+		}
+
+		// Find the median of the medians and partition around it.
+		m := p + (g-1)/2
+		selectAt(A, p, p+g-1, m, cmp)
+		A[m], A[r] = A[r], A[m]
+		q := PartitionFunc(A, p, r, cmp)
+		lo := groupEqual(A, p, q, cmp)
+
+		if t < lo {
+			r = lo - 1
+		} else if t > q {
+			p = q + 1
+		} else {
+			return
+		}
+	}
+	InsertionSortFunc(A[p:r+1], cmp)
+}
+
+// groupEqual moves the elements of A[p..q-1] that are equal to the pivot
+// A[q] next to it, after a Lomuto partition has put every element that
+// is not greater than the pivot into A[p..q-1]. It returns lo such that
+// A[p..lo-1] are less than the pivot and A[lo..q] are equal to it.
+//
+// Time complexity: O(q-p)
+func groupEqual[T any](A []T, p, q int, cmp func(a, b T) int) int {
+	lo := q
+	for j := q - 1; j >= p; j-- {
+		if cmp(A[j], A[q]) == 0 {
+			lo--
+			A[j], A[lo] = A[lo], A[j]
+		}
+	}
+	return lo
+}
+
+// rankIndex returns the index in A[p..r] of the i-th smallest element
+// once A[p..r] is sorted, and panics if there is no such element.
+func rankIndex(p, r, i int) int {
+	if i < 1 || i > r-p+1 {
+		panic("select: rank out of range")
+	}
+	return p + i - 1
+}
@@ -0,0 +1,128 @@
+package sorting
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// selects are the selection algorithms under test.
+var selects = []struct {
+	name       string
+	selectFunc func(A []int, p, r, i int, cmp func(a, b int) int) int
+}{
+	{"RandomizedSelectFunc", RandomizedSelectFunc[int]},
+	{"SelectFunc", SelectFunc[int]},
+}
+
+// checkSelected reports whether A[t] is x and A[p..r] is partitioned
+// around it.
+func checkSelected(A []int, p, r, t, x int) error {
+	if A[t] != x {
+		return fmt.Errorf("A[%d] = %d, want %d", t, A[t], x)
+	}
+	for j := p; j <= r; j++ {
+		if (j < t && A[j] > x) || (j > t && A[j] < x) {
+			return fmt.Errorf("A[%d] = %d is on the wrong side of %d", j, A[j], x)
+		}
+	}
+	return nil
+}
+
+func TestSelect(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	inputs := append(slices.Clone(sortInputs), randomInts(101), make([]int, 64))
+	for _, s := range selects {
+		for _, in := range inputs {
+			if len(in) == 0 {
+				continue
+			}
+			sorted := slices.Clone(in)
+			slices.Sort(sorted)
+
+			// Select every rank of the whole slice, and a random rank of
+			// a random subrange.
+			for i := 1; i <= len(in); i++ {
+				A := slices.Clone(in)
+				got := s.selectFunc(A, 0, len(A)-1, i, cmpInt)
+				if got != sorted[i-1] {
+					t.Fatalf("%s(%v, %d) = %d, want %d", s.name, in, i, got, sorted[i-1])
+				}
+				if err := checkSelected(A, 0, len(A)-1, i-1, got); err != nil {
+					t.Fatalf("%s(%v, %d): %v", s.name, in, i, err)
+				}
+			}
+
+			p := rng.Intn(len(in))
+			r := p + rng.Intn(len(in)-p)
+			i := 1 + rng.Intn(r-p+1)
+			A := slices.Clone(in)
+			sub := slices.Clone(in[p : r+1])
+			slices.Sort(sub)
+			if got := s.selectFunc(A, p, r, i, cmpInt); got != sub[i-1] {
+				t.Errorf("%s(%v, %d, %d, %d) = %d, want %d", s.name, in, p, r, i, got, sub[i-1])
+			}
+			if !slices.Equal(A[:p], in[:p]) || !slices.Equal(A[r+1:], in[r+1:]) {
+				t.Errorf("%s(%v, %d, %d, %d) modified A outside A[p..r]", s.name, in, p, r, i)
+			}
+		}
+	}
+}
+
+func TestSelectRankOutOfRange(t *testing.T) {
+	for _, s := range selects {
+		for _, i := range []int{0, 4} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("%s with rank %d of 3 did not panic", s.name, i)
+					}
+				}()
+				s.selectFunc([]int{3, 1, 2}, 0, 2, i, cmpInt)
+			}()
+		}
+	}
+}
+
+func TestSelectLinear(t *testing.T) {
+	// Sorted, reversed and all-equal inputs must not make Select
+	// quadratic, as they do Quicksort.
+	const n = 1 << 14
+	inputs := map[string][]int{
+		"sorted":    genInts(n, func(i int) int { return i }),
+		"reversed":  genInts(n, func(i int) int { return n - i }),
+		"all-equal": genInts(n, func(int) int { return 7 }),
+		"random":    randomInts(n),
+	}
+	for name, A := range inputs {
+		comparisons := 0
+		SelectFunc(A, 0, n-1, n/2, func(a, b int) int {
+			comparisons++
+			return cmpInt(a, b)
+		})
+		if limit := 20 * n; comparisons > limit {
+			t.Errorf("SelectFunc on %s input made %d comparisons, want at most %d", name, comparisons, limit)
+		}
+	}
+}
+
+func cmpInt(a, b int) int { return a - b }
+
+func BenchmarkRandomizedSelect(b *testing.B) {
+	in := randomInts(benchSize)
+	A := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		RandomizedSelect(A, 0, len(A)-1, len(A)/2)
+	}
+}
+
+func BenchmarkSelect(b *testing.B) {
+	in := randomInts(benchSize)
+	A := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		copy(A, in)
+		Select(A, 0, len(A)-1, len(A)/2)
+	}
+}
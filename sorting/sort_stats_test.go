@@ -0,0 +1,91 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInsertionSortWithStats(t *testing.T) {
+	const n = 100
+	sorted := make([]int, n)
+	reversed := make([]int, n)
+	for i := range sorted {
+		sorted[i] = i
+		reversed[i] = n - i
+	}
+
+	s := InsertionSortWithStats(sorted)
+	if s.Comparisons != n-1 || s.MaxDepth != 1 || s.Allocations != 0 {
+		t.Errorf("InsertionSortWithStats on sorted input = %+v", s)
+	}
+
+	s = InsertionSortWithStats(reversed)
+	if !slices.IsSorted(reversed) {
+		t.Fatalf("InsertionSortWithStats did not sort: %v", reversed)
+	}
+	if want := n * (n - 1) / 2; s.Comparisons != want || s.Moves != want+n-1 {
+		t.Errorf("InsertionSortWithStats on reversed input = %+v, want %d comparisons and %d moves",
+			s, want, want+n-1)
+	}
+}
+
+func TestMergeSortWithStats(t *testing.T) {
+	in := randomInts(1024)
+	got, s := MergeSortWithStats(slices.Clone(in))
+	if want := MergeSort(slices.Clone(in)); !slices.Equal(got, want) {
+		t.Fatal("MergeSortWithStats differs from MergeSort")
+	}
+
+	// n lg n = 10240 moves: every element is written once per level.
+	if s.Moves != 10240 || s.MaxDepth != 11 {
+		t.Errorf("MergeSortWithStats = %+v, want 10240 moves and depth 11", s)
+	}
+	if s.Comparisons > 10240 || s.Allocations < 1023 {
+		t.Errorf("MergeSortWithStats = %+v", s)
+	}
+}
+
+// TestStatsRunPlainSorts checks that every instrumented sort makes the
+// same number of comparisons and gives the same result as the plain sort
+// it instruments.
+func TestStatsRunPlainSorts(t *testing.T) {
+	sorts := []struct {
+		name  string
+		plain func(A []int, cmp func(a, b int) int) []int
+		stats func(A []int) ([]int, SortStats)
+	}{
+		{"InsertionSort", func(A []int, cmp func(a, b int) int) []int {
+			InsertionSortFunc(A, cmp)
+			return A
+		}, func(A []int) ([]int, SortStats) {
+			return A, InsertionSortWithStats(A)
+		}},
+		{"MergeSort", MergeSortFunc[int], MergeSortWithStats[int]},
+		{"Quicksort", func(A []int, cmp func(a, b int) int) []int {
+			QuicksortFunc(A, 0, len(A)-1, cmp)
+			return A
+		}, func(A []int) ([]int, SortStats) {
+			return A, QuicksortWithStats(A, 0, len(A)-1)
+		}},
+		{"HeapSort", func(A []int, cmp func(a, b int) int) []int {
+			HeapSortFunc(A, cmp)
+			return A
+		}, func(A []int) ([]int, SortStats) {
+			return A, HeapSortWithStats(A)
+		}},
+	}
+	for _, s := range sorts {
+		for _, in := range append(slices.Clone(sortInputs), randomInts(200)) {
+			comparisons := 0
+			want := s.plain(slices.Clone(in), countCalls(cmpInt, &comparisons))
+			got, stats := s.stats(slices.Clone(in))
+			if !slices.Equal(got, want) {
+				t.Errorf("%sWithStats(%v) = %v, plain sort gives %v", s.name, in, got, want)
+			}
+			if stats.Comparisons != comparisons {
+				t.Errorf("%sWithStats(%v) made %d comparisons, plain sort %d",
+					s.name, in, stats.Comparisons, comparisons)
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package sorting
+
+import "cmp"
+
+// InsertionSortTraced sorts the given slice exactly like InsertionSort,
+// reporting every step to sink.
+//
+// While A[i] is being inserted, the key is held outside the array and
+// the slot it will eventually fill moves left as larger elements are
+// shifted right. Comparisons with the key are therefore reported as
+// compare(j, j+1), j+1 being that free slot, and every shift and the
+// final placement of the key as a write.
+//
+// Like every traced sort, it runs the code of the plain sort, which
+// reports its steps to sink as it takes them.
+func InsertionSortTraced[T cmp.Ordered](A []T, sink TraceSink[T]) {
+	insertionSort(A, cmp.Compare[T], &probe[T]{sink: sink})
+}
+
+// MergeSortTraced returns a sorted copy of the given slice computed
+// exactly like MergeSort, reporting every step to sink.
+//
+// MergeSort leaves A alone and merges into new slices, so the trace
+// describes the subarrays of A that the recursion works on: every merge
+// is announced by a merge event, its comparisons report the indices the
+// two elements had in A when the merge started, and each element of its
+// result is written back to its place in A. Replaying the trace on A
+// therefore ends in the returned slice.
+func MergeSortTraced[T cmp.Ordered](A []T, sink TraceSink[T]) []T {
+	return mergeSort(A, 0, 1, cmp.Compare[T], &probe[T]{sink: sink})
+}
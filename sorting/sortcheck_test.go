@@ -0,0 +1,166 @@
+package sorting
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// This file is a differential, property-based harness for sorting
+// routines. Every sort is run on many generated inputs and its output is
+// checked against slices.Sort (and slices.SortStableFunc for sorts that
+// claim to be stable). The registry of sorts it runs is in
+// all_sorts_test.go.
+
+// elem is a key tagged with its position in the input, so that the
+// harness can tell equal keys apart.
+type elem struct {
+	key int
+	id  int
+}
+
+func cmpElem(a, b elem) int { return cmp.Compare(a.key, b.key) }
+
+// funcSort is a comparator-driven sort under test. It may sort in place
+// or return a new slice; the harness uses the returned slice.
+type funcSort struct {
+	name   string
+	stable bool
+	sort   func(A []elem, cmp func(a, b elem) int) []elem
+}
+
+// intSort is a sort under test that only works on cmp.Ordered values.
+type intSort struct {
+	name string
+	sort func(A []int) []int
+}
+
+// inputShape generates n keys of one distribution.
+type inputShape struct {
+	name string
+	gen  func(n int, rng *rand.Rand) []int
+}
+
+var inputShapes = []inputShape{
+	{"random", func(n int, rng *rand.Rand) []int {
+		return genInts(n, func(int) int { return rng.Intn(max(n, 1)) })
+	}},
+	{"signed", func(n int, rng *rand.Rand) []int {
+		return genInts(n, func(int) int { return rng.Intn(2*n+1) - n })
+	}},
+	{"sorted", func(n int, _ *rand.Rand) []int {
+		return genInts(n, func(i int) int { return i })
+	}},
+	{"reversed", func(n int, _ *rand.Rand) []int {
+		return genInts(n, func(i int) int { return n - i })
+	}},
+	{"all-equal", func(n int, _ *rand.Rand) []int {
+		return genInts(n, func(int) int { return 7 })
+	}},
+	{"organ-pipe", func(n int, _ *rand.Rand) []int {
+		return genInts(n, func(i int) int { return min(i, n-1-i) })
+	}},
+	{"few-unique", func(n int, rng *rand.Rand) []int {
+		return genInts(n, func(int) int { return rng.Intn(4) })
+	}},
+	{"sawtooth", func(n int, _ *rand.Rand) []int {
+		return genInts(n, func(i int) int { return i % 10 })
+	}},
+}
+
+var inputSizes = []int{0, 1, 2, 3, 10, 33, 100, 1000}
+
+func toElems(keys []int) []elem {
+	E := make([]elem, len(keys))
+	for i, k := range keys {
+		E[i] = elem{key: k, id: i}
+	}
+	return E
+}
+
+// checkFuncSort runs s on a copy of in and checks that the result is a
+// sorted permutation of in, and stable if s claims to be.
+func checkFuncSort(t *testing.T, s funcSort, in []elem) {
+	t.Helper()
+	got := s.sort(slices.Clone(in), cmpElem)
+
+	if len(got) != len(in) {
+		t.Fatalf("%s: got %d elements, want %d", s.name, len(got), len(in))
+	}
+	seen := make([]bool, len(in))
+	for _, e := range got {
+		if e.id < 0 || e.id >= len(in) || seen[e.id] || in[e.id] != e {
+			t.Fatalf("%s: output is not a permutation of the input", s.name)
+		}
+		seen[e.id] = true
+	}
+
+	want := slices.Clone(in)
+	slices.SortFunc(want, cmpElem)
+	for i := range got {
+		if got[i].key != want[i].key {
+			t.Fatalf("%s: output differs from slices.SortFunc at index %d", s.name, i)
+		}
+	}
+
+	if s.stable {
+		want = slices.Clone(in)
+		slices.SortStableFunc(want, cmpElem)
+		if !slices.Equal(got, want) {
+			t.Fatalf("%s: claims to be stable but reorders equal keys", s.name)
+		}
+	}
+}
+
+// checkIntSort runs s on a copy of in and checks the result against
+// slices.Sort.
+func checkIntSort(t *testing.T, s intSort, in []int) {
+	t.Helper()
+	got := s.sort(slices.Clone(in))
+
+	want := slices.Clone(in)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Fatalf("%s: output differs from slices.Sort", s.name)
+	}
+}
+
+// runSortHarness checks every sort against every input shape and size.
+func runSortHarness(t *testing.T, funcSorts []funcSort, intSorts []intSort) {
+	rng := rand.New(rand.NewSource(12))
+	for _, shape := range inputShapes {
+		for _, n := range inputSizes {
+			keys := shape.gen(n, rng)
+			t.Run(fmt.Sprintf("%s/%d", shape.name, n), func(t *testing.T) {
+				for _, s := range funcSorts {
+					checkFuncSort(t, s, toElems(keys))
+				}
+				for _, s := range intSorts {
+					checkIntSort(t, s, keys)
+				}
+			})
+		}
+	}
+}
+
+// fuzzSorts checks every sort on keys decoded from fuzzer input, one
+// byte per key so that duplicates are common.
+func fuzzSorts(f *testing.F, funcSorts []funcSort, intSorts []intSort) {
+	f.Add([]byte{})
+	f.Add([]byte{3, 1, 2})
+	f.Add([]byte("the quick brown fox jumps over the lazy dog"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		keys := make([]int, len(data))
+		for i, b := range data {
+			keys[i] = int(b)
+		}
+		for _, s := range funcSorts {
+			checkFuncSort(t, s, toElems(keys))
+		}
+		for _, s := range intSorts {
+			checkIntSort(t, s, keys)
+		}
+	})
+}
@@ -0,0 +1,502 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	// minMerge is the input length below which TimSort just runs a single
+	// binary insertion sort, and the upper bound for the minimum run length.
+	minMerge = 32
+
+	// minGallop is the number of consecutive wins by one run after which
+	// a merge switches to galloping mode.
+	minGallop = 7
+)
+
+// TimSort sorts the given slice in ascending order with an adaptive,
+// Timsort-style hybrid of InsertionSort and Merge.
+//
+// The algorithm works in three steps:
+//  1. Scan the input for natural runs: maximal nondecreasing runs, or
+//     strictly decreasing runs, which are reversed in place.
+//  2. Extend every run shorter than a computed minimum length (between
+//     16 and 32) with binary insertion sort.
+//  3. Push the runs on a stack and merge neighbours while the stack
+//     invariants are violated, so that merged runs stay balanced.
+//     Merges switch to galloping (exponential search) when one run keeps
+//     winning, copying whole blocks at a time.
+//
+// The sort is stable. On input that is already sorted, or sorted in
+// reverse, it makes n-1 comparisons.
+//
+// Time complexity: O(n) best case, O(n log n) worst case
+// Space complexity: O(n)
+func TimSort[T cmp.Ordered](A []T) {
+	TimSortFunc(A, cmp.Compare[T])
+}
+
+// TimSortFunc is TimSort with elements ordered by the cmp function.
+func TimSortFunc[T any](A []T, cmp func(a, b T) int) {
+	n := len(A)
+	if n < 2 {
+		return
+	}
+	if n < minMerge {
+		runLen := countRunAndMakeAscending(A, 0, n, cmp)
+		binaryInsertionSortFrom(A, runLen, cmp)
+		return
+	}
+
+	ts := &timSorter[T]{A: A, cmp: cmp, minGallop: minGallop}
+	minRun := minRunLength(n)
+	for lo := 0; lo < n; {
+		runLen := countRunAndMakeAscending(A, lo, n, cmp)
+
+		// Extend a short run to min(minRun, remaining) elements.
+		if runLen < minRun {
+			force := min(n-lo, minRun)
+			binaryInsertionSortFrom(A[lo:lo+force], runLen, cmp)
+			runLen = force
+		}
+
+		ts.runs = append(ts.runs, run{base: lo, len: runLen})
+		ts.mergeCollapse()
+		lo += runLen
+	}
+	ts.mergeForceCollapse()
+}
+
+// run is a sorted run A[base : base+len] on the TimSort run stack.
+type run struct {
+	base, len int
+}
+
+// timSorter holds the state of one TimSort call.
+type timSorter[T any] struct {
+	A         []T
+	cmp       func(a, b T) int
+	runs      []run // pending runs, bottom of the stack first
+	tmp       []T   // scratch space for merges, grown on demand
+	minGallop int   // adaptive galloping threshold
+}
+
+// minRunLength returns the minimum run length for an input of n elements:
+// a value k in [minMerge/2, minMerge] such that n/k is a power of two or
+// slightly less than one, so that the final merges are balanced.
+func minRunLength(n int) int {
+	r := 0 // becomes 1 if any bit shifted off is set
+	for n >= minMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// countRunAndMakeAscending returns the length of the run starting at
+// A[lo], scanning no further than A[hi-1]. A strictly decreasing run is
+// reversed in place; requiring strictness keeps the sort stable.
+func countRunAndMakeAscending[T any](A []T, lo, hi int, cmp func(a, b T) int) int {
+	runHi := lo + 1
+	if runHi == hi {
+		return 1
+	}
+
+	if cmp(A[runHi], A[lo]) < 0 {
+		runHi++
+		for runHi < hi && cmp(A[runHi], A[runHi-1]) < 0 {
+			runHi++
+		}
+		slices.Reverse(A[lo:runHi])
+	} else {
+		runHi++
+		for runHi < hi && cmp(A[runHi], A[runHi-1]) >= 0 {
+			runHi++
+		}
+	}
+	return runHi - lo
+}
+
+// mergeCollapse merges runs until the stack invariants hold again for
+// the top four runs X, Y, Z, W (W on top):
+//
+//	len(X) > len(Y) + len(Z), len(Y) > len(Z) + len(W), len(Z) > len(W)
+//
+// Checking four runs rather than three avoids the invariant breaking
+// further down the stack.
+func (ts *timSorter[T]) mergeCollapse() {
+	for len(ts.runs) > 1 {
+		n := len(ts.runs) - 2
+		r := ts.runs
+		if (n > 0 && r[n-1].len <= r[n].len+r[n+1].len) ||
+			(n > 1 && r[n-2].len <= r[n-1].len+r[n].len) {
+			if r[n-1].len < r[n+1].len {
+				n--
+			}
+		} else if r[n].len > r[n+1].len {
+			return
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges all remaining runs into one.
+func (ts *timSorter[T]) mergeForceCollapse() {
+	for len(ts.runs) > 1 {
+		n := len(ts.runs) - 2
+		if n > 0 && ts.runs[n-1].len < ts.runs[n+1].len {
+			n--
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at stack positions i and i+1.
+func (ts *timSorter[T]) mergeAt(i int) {
+	base1, len1 := ts.runs[i].base, ts.runs[i].len
+	base2, len2 := ts.runs[i+1].base, ts.runs[i+1].len
+
+	ts.runs[i].len = len1 + len2
+	ts.runs = append(ts.runs[:i+1], ts.runs[i+2:]...)
+
+	// Elements of run 1 not greater than the first element of run 2
+	// are already in place.
+	k := gallopRight(ts.A[base2], ts.A[base1:base1+len1], 0, ts.cmp)
+	base1 += k
+	len1 -= k
+	if len1 == 0 {
+		return
+	}
+
+	// Elements of run 2 not less than the last element of run 1
+	// are already in place.
+	len2 = gallopLeft(ts.A[base1+len1-1], ts.A[base2:base2+len2], len2-1, ts.cmp)
+	if len2 == 0 {
+		return
+	}
+
+	// Copy the shorter run into tmp.
+	if len1 <= len2 {
+		ts.mergeLo(base1, len1, base2, len2)
+	} else {
+		ts.mergeHi(base1, len1, base2, len2)
+	}
+}
+
+// gallopLeft returns the leftmost position at which key could be inserted
+// into the sorted slice a, i.e. the k with a[k-1] < key <= a[k].
+//
+// The search starts at a[hint] and probes offsets 1, 3, 7, 15, ... until
+// it brackets key, then finishes with a binary search. It costs O(log d)
+// comparisons, where d is the distance between hint and the result.
+func gallopLeft[T any](key T, a []T, hint int, cmp func(a, b T) int) int {
+	lastOfs, ofs := 0, 1
+	if cmp(key, a[hint]) > 0 {
+		// Gallop right until a[hint+lastOfs] < key <= a[hint+ofs].
+		maxOfs := len(a) - hint
+		for ofs < maxOfs && cmp(key, a[hint+ofs]) > 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs += hint
+		ofs += hint
+	} else {
+		// Gallop left until a[hint-ofs] < key <= a[hint-lastOfs].
+		maxOfs := hint + 1
+		for ofs < maxOfs && cmp(key, a[hint-ofs]) <= 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	}
+
+	// Binary search for the answer in (lastOfs, ofs].
+	lastOfs++
+	for lastOfs < ofs {
+		m := lastOfs + (ofs-lastOfs)/2
+		if cmp(key, a[m]) > 0 {
+			lastOfs = m + 1
+		} else {
+			ofs = m
+		}
+	}
+	return ofs
+}
+
+// gallopRight is like gallopLeft but returns the rightmost insertion
+// position, i.e. the k with a[k-1] <= key < a[k].
+func gallopRight[T any](key T, a []T, hint int, cmp func(a, b T) int) int {
+	lastOfs, ofs := 0, 1
+	if cmp(key, a[hint]) < 0 {
+		// Gallop left until a[hint-ofs] <= key < a[hint-lastOfs].
+		maxOfs := hint + 1
+		for ofs < maxOfs && cmp(key, a[hint-ofs]) < 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	} else {
+		// Gallop right until a[hint+lastOfs] <= key < a[hint+ofs].
+		maxOfs := len(a) - hint
+		for ofs < maxOfs && cmp(key, a[hint+ofs]) >= 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs += hint
+		ofs += hint
+	}
+
+	// Binary search for the answer in (lastOfs, ofs].
+	lastOfs++
+	for lastOfs < ofs {
+		m := lastOfs + (ofs-lastOfs)/2
+		if cmp(key, a[m]) < 0 {
+			ofs = m
+		} else {
+			lastOfs = m + 1
+		}
+	}
+	return ofs
+}
+
+// scratch returns a scratch slice of at least n elements.
+func (ts *timSorter[T]) scratch(n int) []T {
+	if len(ts.tmp) < n {
+		ts.tmp = make([]T, max(n, min(2*len(ts.tmp), len(ts.A)/2)))
+	}
+	return ts.tmp
+}
+
+// mergeLo merges the adjacent runs A[base1:base1+len1] and
+// A[base2:base2+len2] from left to right, with len1 <= len2. mergeAt has
+// already ensured that the first element of run 2 comes first and the
+// last element of run 1 comes last.
+func (ts *timSorter[T]) mergeLo(base1, len1, base2, len2 int) {
+	A, cmp := ts.A, ts.cmp
+	tmp := ts.scratch(len1)
+	copy(tmp, A[base1:base1+len1])
+
+	cursor1, cursor2, dest := 0, base2, base1
+	A[dest] = A[cursor2]
+	dest++
+	cursor2++
+	len2--
+	if len2 == 0 {
+		copy(A[dest:], tmp[cursor1:cursor1+len1])
+		return
+	}
+	if len1 == 1 {
+		copy(A[dest:], A[cursor2:cursor2+len2])
+		A[dest+len2] = tmp[cursor1]
+		return
+	}
+
+	gallop := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0 // consecutive wins of each run
+
+		// Plain one-at-a-time merge until one run starts winning
+		// consistently.
+		for {
+			if cmp(A[cursor2], tmp[cursor1]) < 0 {
+				A[dest] = A[cursor2]
+				dest++
+				cursor2++
+				count2++
+				count1 = 0
+				len2--
+				if len2 == 0 {
+					break outer
+				}
+			} else {
+				A[dest] = tmp[cursor1]
+				dest++
+				cursor1++
+				count1++
+				count2 = 0
+				len1--
+				if len1 == 1 {
+					break outer
+				}
+			}
+			if count1 >= gallop || count2 >= gallop {
+				break
+			}
+		}
+
+		// Galloping: copy whole blocks while they stay long.
+		for {
+			count1 = gallopRight(A[cursor2], tmp[cursor1:cursor1+len1], 0, cmp)
+			if count1 != 0 {
+				copy(A[dest:], tmp[cursor1:cursor1+count1])
+				dest += count1
+				cursor1 += count1
+				len1 -= count1
+				if len1 <= 1 {
+					break outer
+				}
+			}
+			A[dest] = A[cursor2]
+			dest++
+			cursor2++
+			len2--
+			if len2 == 0 {
+				break outer
+			}
+
+			count2 = gallopLeft(tmp[cursor1], A[cursor2:cursor2+len2], 0, cmp)
+			if count2 != 0 {
+				copy(A[dest:], A[cursor2:cursor2+count2])
+				dest += count2
+				cursor2 += count2
+				len2 -= count2
+				if len2 == 0 {
+					break outer
+				}
+			}
+			A[dest] = tmp[cursor1]
+			dest++
+			cursor1++
+			len1--
+			if len1 == 1 {
+				break outer
+			}
+
+			gallop--
+			if count1 < minGallop && count2 < minGallop {
+				break
+			}
+		}
+		// Leaving galloping mode is penalized.
+		gallop = max(gallop, 0) + 2
+	}
+	ts.minGallop = max(gallop, 1)
+
+	if len1 == 1 {
+		copy(A[dest:], A[cursor2:cursor2+len2])
+		A[dest+len2] = tmp[cursor1]
+	} else if len1 > 1 {
+		copy(A[dest:], tmp[cursor1:cursor1+len1])
+	}
+	// len1 == 0 only happens with an inconsistent cmp; the rest of run 2
+	// is then already in place.
+}
+
+// mergeHi is the mirror image of mergeLo for len1 >= len2: run 2 is
+// copied into tmp and the runs are merged from right to left.
+func (ts *timSorter[T]) mergeHi(base1, len1, base2, len2 int) {
+	A, cmp := ts.A, ts.cmp
+	tmp := ts.scratch(len2)
+	copy(tmp, A[base2:base2+len2])
+
+	cursor1, cursor2, dest := base1+len1-1, len2-1, base2+len2-1
+	A[dest] = A[cursor1]
+	dest--
+	cursor1--
+	len1--
+	if len1 == 0 {
+		copy(A[dest-(len2-1):], tmp[:len2])
+		return
+	}
+	if len2 == 1 {
+		dest -= len1
+		cursor1 -= len1
+		copy(A[dest+1:], A[cursor1+1:cursor1+1+len1])
+		A[dest] = tmp[cursor2]
+		return
+	}
+
+	gallop := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0 // consecutive wins of each run
+
+		for {
+			if cmp(tmp[cursor2], A[cursor1]) < 0 {
+				A[dest] = A[cursor1]
+				dest--
+				cursor1--
+				count1++
+				count2 = 0
+				len1--
+				if len1 == 0 {
+					break outer
+				}
+			} else {
+				A[dest] = tmp[cursor2]
+				dest--
+				cursor2--
+				count2++
+				count1 = 0
+				len2--
+				if len2 == 1 {
+					break outer
+				}
+			}
+			if count1 >= gallop || count2 >= gallop {
+				break
+			}
+		}
+
+		for {
+			count1 = len1 - gallopRight(tmp[cursor2], A[base1:base1+len1], len1-1, cmp)
+			if count1 != 0 {
+				dest -= count1
+				cursor1 -= count1
+				len1 -= count1
+				copy(A[dest+1:], A[cursor1+1:cursor1+1+count1])
+				if len1 == 0 {
+					break outer
+				}
+			}
+			A[dest] = tmp[cursor2]
+			dest--
+			cursor2--
+			len2--
+			if len2 == 1 {
+				break outer
+			}
+
+			count2 = len2 - gallopLeft(A[cursor1], tmp[:len2], len2-1, cmp)
+			if count2 != 0 {
+				dest -= count2
+				cursor2 -= count2
+				len2 -= count2
+				copy(A[dest+1:], tmp[cursor2+1:cursor2+1+count2])
+				if len2 <= 1 {
+					break outer
+				}
+			}
+			A[dest] = A[cursor1]
+			dest--
+			cursor1--
+			len1--
+			if len1 == 0 {
+				break outer
+			}
+
+			gallop--
+			if count1 < minGallop && count2 < minGallop {
+				break
+			}
+		}
+		gallop = max(gallop, 0) + 2
+	}
+	ts.minGallop = max(gallop, 1)
+
+	if len2 == 1 {
+		dest -= len1
+		cursor1 -= len1
+		copy(A[dest+1:], A[cursor1+1:cursor1+1+len1])
+		A[dest] = tmp[cursor2]
+	} else if len2 > 1 {
+		copy(A[dest-(len2-1):], tmp[:len2])
+	}
+	// len2 == 0 only happens with an inconsistent cmp.
+}
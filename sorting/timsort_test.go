@@ -0,0 +1,89 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// timSortInputs returns inputs of several sizes and shapes, large enough
+// to produce many runs and to trigger galloping.
+func timSortInputs() [][]int {
+	rng := rand.New(rand.NewSource(6))
+	inputs := slices.Clone(sortInputs)
+	for _, n := range []int{31, 32, 33, 100, 1000, 5000} {
+		random := make([]int, n)
+		fewUnique := make([]int, n)
+		sawtooth := make([]int, n)
+		for i := range random {
+			random[i] = rng.Intn(n)
+			fewUnique[i] = rng.Intn(4)
+			sawtooth[i] = i % 97
+		}
+		descending := make([]int, n)
+		for i := range descending {
+			descending[i] = n - i
+		}
+		// Long sorted blocks with a few random elements in between.
+		blocks := make([]int, n)
+		for i := range blocks {
+			blocks[i] = i
+			if i%300 == 0 {
+				blocks[i] = rng.Intn(n)
+			}
+		}
+		inputs = append(inputs, random, fewUnique, sawtooth, descending, blocks)
+	}
+	return inputs
+}
+
+func TestTimSort(t *testing.T) {
+	for _, in := range timSortInputs() {
+		got := slices.Clone(in)
+		TimSort(got)
+
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("TimSort on %d elements (starting %v) is not sorted", len(in), in[:min(len(in), 8)])
+		}
+	}
+}
+
+func TestTimSortFuncStable(t *testing.T) {
+	for _, in := range timSortInputs() {
+		keys := make([]int, len(in))
+		for i, x := range in {
+			keys[i] = x % 5
+		}
+		R := records(keys...)
+		TimSortFunc(R, byKey)
+		if !slices.IsSortedFunc(R, byKey) || !isStable(R) {
+			t.Errorf("TimSortFunc on %d elements is not stable", len(in))
+		}
+	}
+}
+
+func TestTimSortLinearOnSortedInput(t *testing.T) {
+	const n = 10000
+	ascending := make([]int, n)
+	descending := make([]int, n)
+	for i := range ascending {
+		ascending[i] = i
+		descending[i] = n - i
+	}
+
+	for _, A := range [][]int{ascending, descending} {
+		comparisons := 0
+		TimSortFunc(A, func(a, b int) int {
+			comparisons++
+			return a - b
+		})
+		if !slices.IsSorted(A) {
+			t.Fatal("TimSortFunc did not sort presorted input")
+		}
+		if comparisons != n-1 {
+			t.Errorf("TimSortFunc made %d comparisons on presorted input, want %d", comparisons, n-1)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package sorting
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// EventKind identifies what a trace Event describes.
+type EventKind string
+
+const (
+	EventCompare   EventKind = "compare"   // A[I] was compared with A[J]
+	EventSwap      EventKind = "swap"      // A[I] and A[J] were exchanged
+	EventWrite     EventKind = "write"     // Value was stored in A[I]
+	EventPartition EventKind = "partition" // A[Lo..Hi] is about to be partitioned
+	EventHeapify   EventKind = "heapify"   // node I of the heap A[:Hi] is being heapified
+	EventMerge     EventKind = "merge"     // A[Lo:Mid] and A[Mid:Hi] are about to be merged
+)
+
+// Event is one step of a traced sort. Only the fields listed for its
+// Kind are meaningful.
+type Event[T any] struct {
+	Kind        EventKind
+	I, J        int // compare, swap: the two indices; write, heapify: I
+	Lo, Mid, Hi int // partition: Lo, Hi (inclusive); merge: Lo, Mid, Hi; heapify: Hi
+	Value       T   // write
+}
+
+// jsonEvent is the JSON form of an Event. Fields that do not apply to
+// the event's kind are left out.
+type jsonEvent[T any] struct {
+	Op    EventKind `json:"op"`
+	I     *int      `json:"i,omitempty"`
+	J     *int      `json:"j,omitempty"`
+	Lo    *int      `json:"lo,omitempty"`
+	Mid   *int      `json:"mid,omitempty"`
+	Hi    *int      `json:"hi,omitempty"`
+	Value *T        `json:"v,omitempty"`
+}
+
+// MarshalJSON encodes e as a flat object with only the fields of its
+// kind, e.g. {"op":"swap","i":3,"j":7}.
+func (e Event[T]) MarshalJSON() ([]byte, error) {
+	j := jsonEvent[T]{Op: e.Kind}
+	switch e.Kind {
+	case EventCompare, EventSwap:
+		j.I, j.J = &e.I, &e.J
+	case EventWrite:
+		j.I, j.Value = &e.I, &e.Value
+	case EventPartition:
+		j.Lo, j.Hi = &e.Lo, &e.Hi
+	case EventHeapify:
+		j.I, j.Hi = &e.I, &e.Hi
+	case EventMerge:
+		j.Lo, j.Mid, j.Hi = &e.Lo, &e.Mid, &e.Hi
+	default:
+		return nil, fmt.Errorf("unknown event kind %q", e.Kind)
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes an event written by MarshalJSON.
+func (e *Event[T]) UnmarshalJSON(data []byte) error {
+	var j jsonEvent[T]
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*e = Event[T]{Kind: j.Op}
+	set := func(dst, src *int) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	set(&e.I, j.I)
+	set(&e.J, j.J)
+	set(&e.Lo, j.Lo)
+	set(&e.Mid, j.Mid)
+	set(&e.Hi, j.Hi)
+	if j.Value != nil {
+		e.Value = *j.Value
+	}
+	return nil
+}
+
+// TraceSink receives the events of a traced sort, in order.
+type TraceSink[T any] interface {
+	Emit(e Event[T])
+}
+
+// TraceFunc adapts an ordinary function to a TraceSink.
+type TraceFunc[T any] func(e Event[T])
+
+// Emit calls f(e).
+func (f TraceFunc[T]) Emit(e Event[T]) { f(e) }
+
+// TraceRecorder is a TraceSink that keeps every event in memory.
+type TraceRecorder[T any] struct {
+	Events []Event[T]
+}
+
+// Emit appends e to r.Events.
+func (r *TraceRecorder[T]) Emit(e Event[T]) {
+	r.Events = append(r.Events, e)
+}
+
+// JSONLinesSink is a TraceSink that writes every event to an io.Writer
+// as one JSON object per line (JSON Lines).
+//
+// Output is buffered; call Flush when the sort is done. Emit cannot
+// report errors, so the first one is kept and returned by Flush.
+type JSONLinesSink[T any] struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+	err error
+}
+
+// NewJSONLinesSink returns a JSONLinesSink writing to w.
+func NewJSONLinesSink[T any](w io.Writer) *JSONLinesSink[T] {
+	bw := bufio.NewWriter(w)
+	return &JSONLinesSink[T]{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Emit writes e as one line. It does nothing after an error.
+func (s *JSONLinesSink[T]) Emit(e Event[T]) {
+	if s.err == nil {
+		s.err = s.enc.Encode(e)
+	}
+}
+
+// Flush writes any buffered events and returns the first error seen.
+func (s *JSONLinesSink[T]) Flush() error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.w.Flush()
+}
+
+// ReadJSONLines decodes a trace written by JSONLinesSink.
+func ReadJSONLines[T any](r io.Reader) ([]Event[T], error) {
+	var events []Event[T]
+	dec := json.NewDecoder(r)
+	for {
+		var e Event[T]
+		if err := dec.Decode(&e); err == io.EOF {
+			return events, nil
+		} else if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+}
+
+// Replay rebuilds the intermediate states of a traced sort. Starting from
+// a copy of the input the sort was given, it applies the events one by
+// one and calls visit after each, with the index of the event and the
+// array state right after it. Only swap and write events change the
+// state. visit must not keep or modify state; Replay returns the final
+// state.
+func Replay[T any](initial []T, events []Event[T], visit func(step int, e Event[T], state []T)) []T {
+	state := append([]T(nil), initial...)
+	for step, e := range events {
+		switch e.Kind {
+		case EventSwap:
+			state[e.I], state[e.J] = state[e.J], state[e.I]
+		case EventWrite:
+			state[e.I] = e.Value
+		}
+		if visit != nil {
+			visit(step, e, state)
+		}
+	}
+	return state
+}
@@ -0,0 +1,121 @@
+package sorting
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestTracesReplayToPlainSortOutput checks that every traced sort gives
+// the output of the plain sort it traces, and that replaying its trace
+// on the input ends in that same output.
+func TestTracesReplayToPlainSortOutput(t *testing.T) {
+	sorts := []struct {
+		name   string
+		plain  func([]int) []int
+		traced func([]int, TraceSink[int]) []int
+	}{
+		{"InsertionSort", func(A []int) []int {
+			InsertionSort(A)
+			return A
+		}, func(A []int, sink TraceSink[int]) []int {
+			InsertionSortTraced(A, sink)
+			return A
+		}},
+		{"MergeSort", MergeSort[int], MergeSortTraced[int]},
+		{"Quicksort", func(A []int) []int {
+			Quicksort(A, 0, len(A)-1)
+			return A
+		}, func(A []int, sink TraceSink[int]) []int {
+			QuicksortTraced(A, 0, len(A)-1, sink)
+			return A
+		}},
+		{"RandomizedQuicksort", func(A []int) []int {
+			RandomizedQuicksort(A, 0, len(A)-1)
+			return A
+		}, func(A []int, sink TraceSink[int]) []int {
+			RandomizedQuicksortTraced(A, 0, len(A)-1, sink)
+			return A
+		}},
+		{"HeapSort", func(A []int) []int {
+			HeapSort(A)
+			return A
+		}, func(A []int, sink TraceSink[int]) []int {
+			HeapSortTraced(A, sink)
+			return A
+		}},
+	}
+	for _, s := range sorts {
+		for _, in := range append(slices.Clone(sortInputs), randomInts(100)) {
+			want := s.plain(slices.Clone(in))
+
+			var rec TraceRecorder[int]
+			got := s.traced(slices.Clone(in), &rec)
+			if !slices.Equal(got, want) {
+				t.Fatalf("%sTraced(%v) = %v, plain sort gives %v", s.name, in, got, want)
+			}
+			if final := Replay(in, rec.Events, nil); !slices.Equal(final, want) {
+				t.Errorf("%sTraced(%v): replay ends in %v, plain sort gives %v", s.name, in, final, want)
+			}
+		}
+	}
+}
+
+func TestMergeSortTracedMergeEvents(t *testing.T) {
+	var rec TraceRecorder[int]
+	MergeSortTraced([]int{4, 3, 2, 1}, &rec)
+
+	var merges []Event[int]
+	for _, e := range rec.Events {
+		if e.Kind == EventMerge {
+			merges = append(merges, e)
+		}
+	}
+	want := []Event[int]{
+		{Kind: EventMerge, Lo: 0, Mid: 1, Hi: 2},
+		{Kind: EventMerge, Lo: 2, Mid: 3, Hi: 4},
+		{Kind: EventMerge, Lo: 0, Mid: 2, Hi: 4},
+	}
+	if !slices.Equal(merges, want) {
+		t.Errorf("merge events = %v, want %v", merges, want)
+	}
+}
+
+func TestJSONLinesRoundTrip(t *testing.T) {
+	in := []int{3, 1, 2}
+	var buf bytes.Buffer
+	sink := NewJSONLinesSink[int](&buf)
+	MergeSortTraced(slices.Clone(in), sink)
+	if err := sink.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != `{"op":"merge","lo":1,"mid":2,"hi":3}` {
+		t.Errorf("first line = %s", lines[0])
+	}
+
+	events, err := ReadJSONLines[int](&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != len(lines) {
+		t.Fatalf("read %d events, wrote %d lines", len(events), len(lines))
+	}
+
+	var states [][]int
+	final := Replay(in, events, func(step int, e Event[int], state []int) {
+		if e.Kind == EventWrite {
+			states = append(states, slices.Clone(state))
+		}
+	})
+	if !slices.Equal(final, []int{1, 2, 3}) {
+		t.Errorf("replayed trace ends in %v", final)
+	}
+	// Merging [1] and [2] writes both back in place; the second merge
+	// then overwrites A[0] before A[1] gets the 3.
+	if !slices.Equal(states[1], []int{3, 1, 2}) || !slices.Equal(states[2], []int{1, 1, 2}) {
+		t.Errorf("intermediate states = %v", states)
+	}
+}